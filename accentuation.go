@@ -1,8 +1,13 @@
 package greekaccentuation
 
 import (
+	"errors"
+	"fmt"
 	"sort"
 	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 type Accentuation int
@@ -65,6 +70,8 @@ func (e Accentuation) Value() (int, Accent) {
 
 func (e Accentuation) Name() string {
 	switch e {
+	case NO_ACCENTUATION:
+		return "NO_ACCENTUATION"
 	case OXYTONE:
 		return "OXYTONE"
 	case PERISPOMENON:
@@ -95,10 +102,17 @@ func syllableAddAccent(s string, a Accent) string {
 	o, n, c := onsetNucleusCoda(s)
 	ro := []rune(o)
 	rn := []rune(n)
+	// The accent and breathing go on the long vowel before an iota
+	// adscript (τῶι), not on the iota
+	adscript := ""
+	if d := []rune(norm.NFD.String(n)); isAdscript(d, len(d)-1, false) {
+		rn = []rune(norm.NFC.String(string(d[:len(d)-1])))
+		adscript = string(d[len(d)-1])
+	}
 	if len(ro) == 1 && breathing(ro[0]) != nil {
-		return string(AddDiacritic(AddDiacritic(rn, ro[0]), a.Rune())) + c
+		return string(AddDiacritic(AddDiacritic(rn, ro[0]), a.Rune())) + adscript + c
 	} else {
-		return o + string(AddDiacritic(rn, a.Rune())) + c
+		return o + string(AddDiacritic(rn, a.Rune())) + adscript + c
 	}
 
 }
@@ -181,10 +195,10 @@ func getAccentuation(w string) Accentuation {
 	return 0
 }
 
-//func possibleAccentuations(s []string, treat_final_AI_OI_short=True, default_short=False) {
-func possibleAccentuations(s []string, treat_final_AI_OI_short bool, defaultShort bool) []Accentuation {
-	var yield []Accentuation
-
+// accentLengths returns the lengths of the ultima and penult that decide
+// which accentuations are possible. The penult length is 0 if the word
+// has a single syllable.
+func accentLengths(s []string, treat_final_AI_OI_short bool, defaultShort bool) (Length, Length) {
 	ultimaLength := syllableLength(s[len(s)-1], treat_final_AI_OI_short)
 	var penultLength Length
 	if len(s) >= 2 {
@@ -196,6 +210,19 @@ func possibleAccentuations(s []string, treat_final_AI_OI_short bool, defaultShor
 	if penultLength == UNKNOWN && defaultShort {
 		penultLength = SHORT
 	}
+	return ultimaLength, penultLength
+}
+
+//func possibleAccentuations(s []string, treat_final_AI_OI_short=True, default_short=False) {
+func possibleAccentuations(s []string, treat_final_AI_OI_short bool, defaultShort bool) []Accentuation {
+	ultimaLength, penultLength := accentLengths(s, treat_final_AI_OI_short, defaultShort)
+	return allowedAccentuations(len(s), ultimaLength, penultLength)
+}
+
+// allowedAccentuations returns the accentuations the law of limitation
+// allows for a word of n syllables with the given ultima and penult.
+func allowedAccentuations(n int, ultimaLength, penultLength Length) []Accentuation {
+	var yield []Accentuation
 
 	yield = append(yield, OXYTONE)
 
@@ -203,32 +230,116 @@ func possibleAccentuations(s []string, treat_final_AI_OI_short bool, defaultShor
 		yield = append(yield, PERISPOMENON)
 	}
 
-	if len(s) >= 2 && !(penultLength == LONG && ultimaLength == SHORT) {
+	if n >= 2 && !(penultLength == LONG && ultimaLength == SHORT) {
 		yield = append(yield, PAROXYTONE)
 	}
 
-	if len(s) >= 2 && !(penultLength == SHORT || ultimaLength == LONG) {
+	if n >= 2 && !(penultLength == SHORT || ultimaLength == LONG) {
 		yield = append(yield, PROPERISPOMENON)
 	}
 
-	if len(s) >= 3 && !(ultimaLength == LONG) {
+	if n >= 3 && !(ultimaLength == LONG) {
 		yield = append(yield, PROPAROXYTONE)
 	}
 
 	return yield
 }
 
+// GetAccentuation returns the accentuation of an accented word, or
+// NO_ACCENTUATION if it has no acute or circumflex on its last three
+// syllables.
+func GetAccentuation(word string) Accentuation {
+	return getAccentuation(norm.NFC.String(word))
+}
+
+// PossibleAccentuations returns the accentuations the law of limitation
+// allows for a word, ordered from the ultima back.
+func PossibleAccentuations(word string, options Options) []Accentuation {
+	s := Syllabify(unaccented(word))
+	if !hasVowel(s) {
+		return nil
+	}
+	ultimaLength, penultLength := options.lengths(s)
+	if length, ok := ultimaException(word, ""); ok {
+		ultimaLength = length
+	}
+	return allowedAccentuations(len(s), ultimaLength, penultLength)
+}
+
+// ApplyAccentuation removes any accent from a word and gives it the
+// accentuation a. It does not check the accentuation against the law of
+// limitation; use PossibleAccentuations for that. An error is returned if
+// the word has too few syllables for the accentuation, and
+// ErrNoAccentuation if it has no vowel to take one.
+func ApplyAccentuation(word string, a Accentuation, options Options) (string, error) {
+	s := Syllabify(unaccented(word))
+	if !hasVowel(s) {
+		return "", ErrNoAccentuation
+	}
+	position := a.Position()
+	if position == 0 {
+		return "", fmt.Errorf("greekaccentuation: cannot apply %s", a.Name())
+	}
+	if position > len(s) {
+		return "", fmt.Errorf("greekaccentuation: %q has too few syllables to be %s",
+			word, DisplayAccentuation(a))
+	}
+	return options.Form.String(fixBrokenUnicode(addAccentuation(s, a))), nil
+}
+
+// hasVowel reports whether any of the syllables has a vowel.
+func hasVowel(s []string) bool {
+	for _, syllable := range s {
+		for _, ch := range syllable {
+			if IsVowel(unicode.ToLower(Base(ch))) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unaccented returns a word in NFC with its accents removed.
+func unaccented(word string) string {
+	return string(StripAccents([]rune(norm.NFC.String(word))))
+}
+
 // Recessive does something that I am sure is interesting, but I don't know what.
 //func Recessive(w string, treat_final_AI_OI_short=True, default_short=False)
 func Recessive(w string, treat_final_AI_OI_short bool, default_short bool) string {
+	return recessive(w, Options{FinalDiphthongsLong: !treat_final_AI_OI_short, DefaultShort: default_short})
+}
+
+// RecessiveWith places the accent as far from the ultima as the law of
+// limitation allows, following options. A prefix separated by | is left
+// unaccented. In Doric a THIRD_PLURAL in -ον or -αν is accented on the
+// penult, with a circumflex only when the penult is known to be long.
+func RecessiveWith(w string, options Options) string {
+	return options.Form.String(recessive(w, options))
+}
+
+func recessive(w string, options Options) string {
 	pre := ""
 	parts := strings.SplitN(w, "|", 2)
 	if len(parts) > 1 {
 		pre = parts[0]
 		w = parts[1]
 	}
+	if name, ok := options.Names.Lookup(w); ok {
+		return pre + name
+	}
 	s := Syllabify(w)
-	ll := possibleAccentuations(s, treat_final_AI_OI_short, default_short)
+	ultimaLength, penultLength := options.lengths(s)
+	if length, ok := ultimaException(w, ""); ok {
+		ultimaLength = length
+	}
+	ll := allowedAccentuations(len(s), ultimaLength, penultLength)
+	if options.Dialect == DORIC && options.Inflection == THIRD_PLURAL && len(s) >= 2 && hasEnding(w, "ον", "αν") {
+		if syllableLength(s[len(s)-2], false) == LONG && accentationInSet(PROPERISPOMENON, ll) {
+			return pre + addAccentuation(s, PROPERISPOMENON)
+		}
+		return pre + addAccentuation(s, PAROXYTONE)
+	}
 	sort.Sort(ByAccentReverse(ll))
 	if len(ll) == 0 {
 		return w
@@ -260,36 +371,100 @@ func OnPenult(w string, default_short bool) string {
 }
 
 // Persistent returns the accented form of a word. Returns an empty string
-// if the dictionary entry contains no accent.
+// if the dictionary entry contains no accent or the accent of the lemma
+// cannot be placed on the word.
 //func Persistent(w string, lemma string, default_short=False) {
 func Persistent(word string, lemma string, defaultShort bool) string {
+	w, _ := persistent(word, lemma, persistentOptions(defaultShort), nil)
+	return w
+}
+
+// persistentOptions returns the options Persistent has always used:
+// final -αι and -οι count as long.
+func persistentOptions(defaultShort bool) Options {
+	return Options{FinalDiphthongsLong: true, DefaultShort: defaultShort}
+}
+
+// PersistentWith is Persistent following options. Unlike Persistent it
+// counts a final -αι or -οι as short unless FinalDiphthongsLong is set,
+// as the other functions taking Options do, so Persistent(w, l, false)
+// is PersistentWith(w, l, Options{FinalDiphthongsLong: true}). The
+// genitive and
+// dative of a third declension monosyllable are accented on the ultima
+// (φλεβός) unless the lemma is registered as an exception. In Aeolic
+// words of more than one syllable take the recessive accent whatever the
+// accent of the lemma, and in Doric a GENITIVE_PLURAL in -ων or -ᾱν is
+// perispomenon.
+func PersistentWith(word string, lemma string, options Options) string {
+	w, _ := persistent(word, lemma, options, nil)
+	return options.Form.String(w)
+}
+
+// ErrNoAccent is returned for a lemma that carries no accent.
+var ErrNoAccent = errors.New("greekaccentuation: lemma has no accent")
+
+// ErrNoAccentuation is returned when the accent of the lemma cannot be
+// placed on the word, or when a word has no vowel to take an accent.
+var ErrNoAccentuation = errors.New("greekaccentuation: no accentuation is possible")
+
+// persistent implements Persistent, recording each decision in trace if
+// it is not nil. It returns ErrNoAccent or ErrNoAccentuation, and an
+// empty form, if the word cannot be accented.
+func persistent(word string, lemma string, options Options, trace *PersistentTrace) (string, error) {
 	w := strings.ReplaceAll(word, "|", "")
 
+	if name, ok := options.Names.Lookup(lemma); ok {
+		trace.note("the lemma is an indeclinable name")
+		return name, nil
+	}
+	if name, ok := options.Names.Lookup(w); ok {
+		trace.note("the word is an indeclinable name")
+		return name, nil
+	}
+
 	// Get accentuation of the lemma
 	accentuation := getAccentuation(lemma)
+	if trace != nil {
+		trace.LemmaAccentuation = accentuation
+		trace.LemmaSyllables = Syllabify(lemma)
+	}
 	if accentuation == NO_ACCENTUATION {
 		// Why was this behaviour chosen? In this case I would prefer to
 		// return an unaccented string for all alternate forms.
-		return ""
+		trace.note("the lemma has no accent")
+		return "", ErrNoAccent
 	}
 	place, accent := accentuation.Value()
 
 	s := Syllabify(w)
-	possible := possibleAccentuations(s, false, defaultShort)
+	ultimaLength, penultLength := options.lengths(s)
+	limitLength := ultimaLength
+	if length, ok := ultimaException(w, lemma); ok {
+		limitLength = length
+		trace.note(fmt.Sprintf("the ultima counts as %s for the law of limitation", length.Name()))
+	}
+	possible := allowedAccentuations(len(s), limitLength, penultLength)
 	place2 := len(s) - len(Syllabify(lemma)) + place
 	accentPair := findMatchingAccentuation(place2, accent)
+	if trace != nil {
+		trace.WordSyllables = s
+		trace.UltimaLength, trace.PenultLength = ultimaLength, penultLength
+		trace.Allowed = possible
+		trace.Position = place2
+	}
+	trace.try("lemma accent", place2, accent, accentPair, possible)
 
 	if !accentuationInSet(accentPair, possible) {
 		opt1 := findMatchingAccentuation(place2, CIRCUMFLEX)
 		opt2 := findMatchingAccentuation(place2, ACUTE)
-		if accent == ACUTE && accentuationInSet(opt1, possible) {
+		if accent == ACUTE && trace.try("circumflex in place of acute", place2, CIRCUMFLEX, opt1, possible) {
 			accentPair = opt1
-		} else if accent == CIRCUMFLEX && accentuationInSet(opt2, possible) {
+		} else if accent == CIRCUMFLEX && trace.try("acute in place of circumflex", place2, ACUTE, opt2, possible) {
 			accentPair = opt2
 		} else {
 			for i := 1; i <= 4; i++ {
 				opt := findMatchingAccentuation(place2-i, ACUTE)
-				if accentuationInSet(opt, possible) {
+				if trace.try("acute nearer the ultima", place2-i, ACUTE, opt, possible) {
 					accentPair = opt
 					break
 				}
@@ -297,9 +472,28 @@ func Persistent(word string, lemma string, defaultShort bool) string {
 		}
 	}
 
+	if rule, a := options.monosyllableAccentuation(lemma, s, ultimaLength); rule != "" {
+		if trace.try(rule, a.Position(), a.Character(), a, possible) {
+			accentPair = a
+		}
+	}
+	if rule, a := options.dialectAccentuation(s, possible); rule != "" {
+		if trace.try(rule, a.Position(), a.Character(), a, possible) {
+			accentPair = a
+		}
+	}
+
+	if trace != nil {
+		trace.Result = accentPair
+	}
+	if accentPair == NO_ACCENTUATION {
+		trace.note("no accentuation is possible")
+		return "", ErrNoAccentuation
+	}
+
 	// Why is a stray grave sneaking in at some points. TOFIX
 	// Probably some NFC/NFD issue at some point
-	return fixBrokenUnicode(addAccentuation(s, Accentuation(accentPair)))
+	return fixBrokenUnicode(addAccentuation(s, Accentuation(accentPair))), nil
 	//return addAccentuation(s, Accentuation(accentPair))
 }
 
@@ -98,6 +98,17 @@ func TestPersistent(t *testing.T) {
 	if Persistent("περιπατεις", "περιπατῶ", false) != "περιπατεῖς" {
 		t.Fatalf("Persistent() failed. Returned %s", Persistent("περιπατεις", "περιπατεῖς", false))
 	}
+	// An accent that cannot be placed gives a blank string, as it does
+	// when the steps are traced
+	if Persistent("λος", "καταλαμβάνω", false) != "" {
+		t.Fatalf("Persistent() failed. Returned %s", Persistent("λος", "καταλαμβάνω", false))
+	}
+	if _, err := persistent("λος", "καταλαμβάνω", persistentOptions(false), nil); err != ErrNoAccentuation {
+		t.Fatalf("persistent() failed. Returned %v", err)
+	}
+	if _, err := persistent("Ααρων", "Ααρων", persistentOptions(false), nil); err != ErrNoAccent {
+		t.Fatalf("persistent() failed. Returned %v", err)
+	}
 	//if Persistent("περιπατει", "περιπατέω", false) != "περιπατεῖ" {
 	//	t.Fatalf("Persistent() failed. Returned %s", Persistent("περιπατει", "περιπατέω", false))
 	//}
@@ -144,3 +155,110 @@ func stringArrayMatch(a, b []string) bool {
 	}
 	return true
 }
+
+func TestGetAccentuation(t *testing.T) {
+	tests := map[string]Accentuation{
+		"θεός":                   OXYTONE,
+		"θεοῦ":                   PERISPOMENON,
+		"λόγος":                  PAROXYTONE,
+		"δῶρον":                  PROPERISPOMENON,
+		"ἄνθρωπος":               PROPAROXYTONE,
+		"θεος":                   NO_ACCENTUATION,
+		norm.NFD.String("λόγος"): PAROXYTONE,
+	}
+	for word, expected := range tests {
+		if a := GetAccentuation(word); a != expected {
+			t.Errorf("GetAccentuation(%q) = %s, expected %s", word, a.Name(), expected.Name())
+		}
+	}
+}
+
+func TestPersistentWithDefaults(t *testing.T) {
+	// Persistent counts a final -οι as long, the zero Options as short
+	if got := Persistent("ἀνθρωποι", "ἄνθρωπος", false); got != "ἀνθρώποι" {
+		t.Errorf("Persistent() = %q", got)
+	}
+	if got := PersistentWith("ἀνθρωποι", "ἄνθρωπος", Options{}); got != "ἄνθρωποι" {
+		t.Errorf("PersistentWith() = %q", got)
+	}
+	for _, p := range []struct{ word, lemma string }{
+		{"ἀνθρωποι", "ἄνθρωπος"}, {"ἀνθρωπου", "ἄνθρωπος"}, {"χωραι", "χώρα"}, {"περιπατει", "περιπατῶ"},
+	} {
+		got := PersistentWith(p.word, p.lemma, Options{FinalDiphthongsLong: true})
+		if expected := Persistent(p.word, p.lemma, false); got != expected {
+			t.Errorf("PersistentWith(%q, %q) = %q, expected %q as Persistent", p.word, p.lemma, got, expected)
+		}
+	}
+}
+
+func TestExportedPossibleAccentuations(t *testing.T) {
+	tests := []struct {
+		word     string
+		options  Options
+		expected []Accentuation
+	}{
+		{"ἀνθρωποι", Options{}, []Accentuation{OXYTONE, PROPERISPOMENON, PROPAROXYTONE}},
+		{"ἀνθρωποι", Options{FinalDiphthongsLong: true}, []Accentuation{OXYTONE, PERISPOMENON, PAROXYTONE}},
+		{"ἄνθρωπος", Options{}, []Accentuation{OXYTONE, PROPERISPOMENON, PROPAROXYTONE}},
+		{"χωρα", Options{}, []Accentuation{OXYTONE, PERISPOMENON, PAROXYTONE, PROPERISPOMENON}},
+		{"χωρα", Options{DefaultShort: true}, []Accentuation{OXYTONE, PROPERISPOMENON}},
+		{"χωρα", Options{LengthResolver: func(s string, final bool) Length {
+			return LONG
+		}}, []Accentuation{OXYTONE, PERISPOMENON, PAROXYTONE}},
+	}
+	for _, test := range tests {
+		got := PossibleAccentuations(test.word, test.options)
+		if accentuationNames(got) != accentuationNames(test.expected) {
+			t.Errorf("PossibleAccentuations(%q, %+v) = %s, expected %s", test.word, test.options,
+				accentuationNames(got), accentuationNames(test.expected))
+		}
+	}
+}
+
+func TestApplyAccentuation(t *testing.T) {
+	tests := []struct {
+		word     string
+		a        Accentuation
+		options  Options
+		expected string
+	}{
+		{"ἀνθρωπος", PROPAROXYTONE, Options{}, "ἄνθρωπος"},
+		{"ἄνθρωπος", PAROXYTONE, Options{}, "ἀνθρώπος"},
+		{"δωρον", PROPERISPOMENON, Options{}, "δῶρον"},
+		{"θεος", OXYTONE, Options{Form: norm.NFD}, norm.NFD.String("θεός")},
+	}
+	for _, test := range tests {
+		got, err := ApplyAccentuation(test.word, test.a, test.options)
+		if err != nil {
+			t.Errorf("ApplyAccentuation(%q, %s) failed: %v", test.word, test.a.Name(), err)
+		} else if got != test.expected {
+			t.Errorf("ApplyAccentuation(%q, %s) = %q, expected %q", test.word, test.a.Name(), got, test.expected)
+		}
+	}
+	if _, err := ApplyAccentuation("θεος", PROPAROXYTONE, Options{}); err == nil {
+		t.Errorf("ApplyAccentuation() should fail for a word of two syllables")
+	}
+	if _, err := ApplyAccentuation("θεος", NO_ACCENTUATION, Options{}); err == nil {
+		t.Errorf("ApplyAccentuation() should fail for NO_ACCENTUATION")
+	}
+	for _, word := range []string{"", "γγγ", "δ᾽"} {
+		if got, err := ApplyAccentuation(word, OXYTONE, Options{}); err != ErrNoAccentuation {
+			t.Errorf("ApplyAccentuation(%q) = %q, %v, expected ErrNoAccentuation", word, got, err)
+		}
+		if got := PossibleAccentuations(word, Options{}); got != nil {
+			t.Errorf("PossibleAccentuations(%q) = %s, expected none", word, accentuationNames(got))
+		}
+	}
+}
+
+func TestDiaeresisAccentuation(t *testing.T) {
+	if Recessive("προϊστημι", true, false) != "προΐστημι" {
+		t.Fatalf("Recessive() failed. Returned %s", Recessive("προϊστημι", true, false))
+	}
+	if Persistent("ἀϊδιου", "ἀΐδιος", false) != "ἀϊδίου" {
+		t.Fatalf("Persistent() failed. Returned %s", Persistent("ἀϊδιου", "ἀΐδιος", false))
+	}
+	if Persistent("Μωϋσεως", "Μωϋσῆς", false) != "Μωϋσέως" {
+		t.Fatalf("Persistent() failed. Returned %s", Persistent("Μωϋσεως", "Μωϋσῆς", false))
+	}
+}
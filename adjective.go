@@ -0,0 +1,281 @@
+package greekaccentuation
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+type Gender int
+
+const (
+	MASCULINE Gender = 0
+	FEMININE  Gender = 1
+	NEUTER    Gender = 2
+)
+
+func (e Gender) Name() string {
+	switch e {
+	case MASCULINE:
+		return "MASCULINE"
+	case FEMININE:
+		return "FEMININE"
+	case NEUTER:
+		return "NEUTER"
+	}
+	return ""
+}
+
+type Case int
+
+const (
+	NOMINATIVE Case = 0
+	GENITIVE   Case = 1
+	DATIVE     Case = 2
+	ACCUSATIVE Case = 3
+	VOCATIVE   Case = 4
+)
+
+func (e Case) Name() string {
+	switch e {
+	case NOMINATIVE:
+		return "NOMINATIVE"
+	case GENITIVE:
+		return "GENITIVE"
+	case DATIVE:
+		return "DATIVE"
+	case ACCUSATIVE:
+		return "ACCUSATIVE"
+	case VOCATIVE:
+		return "VOCATIVE"
+	}
+	return ""
+}
+
+type Number int
+
+const (
+	SINGULAR Number = 0
+	PLURAL   Number = 1
+)
+
+func (e Number) Name() string {
+	switch e {
+	case SINGULAR:
+		return "SINGULAR"
+	case PLURAL:
+		return "PLURAL"
+	}
+	return ""
+}
+
+// endingAccent says how a form with an ending is accented.
+type endingAccent int
+
+const (
+	// persistentEnding keeps the accent of the nominative singular as far
+	// as the law of limitation allows.
+	persistentEnding endingAccent = iota
+	// circumflexEnding is persistent, but an accent on the ultima is a
+	// circumflex: the genitive and dative of the second declension
+	// (καλοῦ) and contracted endings (ἀληθοῦς).
+	circumflexEnding
+	// perispomenonEnding is the first declension genitive plural in -ῶν.
+	perispomenonEnding
+	// recessiveEnding is recessive unless the nominative is oxytone, as
+	// in the third declension vocative (εὔδαιμον, but ἀληθές).
+	recessiveEnding
+)
+
+type ending struct {
+	text   string // with the length of an α ι υ marked
+	accent endingAccent
+	// masculine takes the accent from the masculine nominative singular
+	// rather than that of the gender of the form.
+	masculine bool
+}
+
+// declension holds the endings of one gender, by number and then case.
+type declension [2][5]ending
+
+func plain(text string) ending        { return ending{text, persistentEnding, false} }
+func circumflexed(text string) ending { return ending{text, circumflexEnding, false} }
+func masculine(text string) ending    { return ending{text, circumflexEnding, true} }
+
+var (
+	secondMasculine = declension{
+		{plain("ος"), circumflexed("ου"), circumflexed("ῳ"), plain("ον"), plain("ε")},
+		{plain("οι"), circumflexed("ων"), circumflexed("οις"), plain("ους"), plain("οι")},
+	}
+	secondNeuter = declension{
+		{plain("ον"), circumflexed("ου"), circumflexed("ῳ"), plain("ον"), plain("ον")},
+		{plain("ᾰ"), circumflexed("ων"), circumflexed("οις"), plain("ᾰ"), plain("ᾰ")},
+	}
+	// firstEta and firstAlpha are the feminines of second declension
+	// adjectives. Their nominative, vocative and genitive plural are
+	// accented as the masculine (ἄξιαι, δικαίων), not with the -ῶν of
+	// first declension nouns.
+	firstEta = declension{
+		{plain("η"), circumflexed("ης"), circumflexed("ῃ"), plain("ην"), plain("η")},
+		{masculine("αι"), masculine("ων"), circumflexed("αις"), plain("ᾱς"), masculine("αι")},
+	}
+	firstAlpha = declension{
+		{plain("ᾱ"), circumflexed("ᾱς"), circumflexed("ᾳ"), plain("ᾱν"), plain("ᾱ")},
+		{masculine("αι"), masculine("ων"), circumflexed("αις"), plain("ᾱς"), masculine("αι")},
+	}
+	sigmaStem = declension{
+		{plain("ης"), circumflexed("ους"), circumflexed("ει"), circumflexed("η"), {"ες", recessiveEnding, false}},
+		{circumflexed("εις"), circumflexed("ων"), plain("εσι"), circumflexed("εις"), circumflexed("εις")},
+	}
+	sigmaStemNeuter = declension{
+		{plain("ες"), circumflexed("ους"), circumflexed("ει"), plain("ες"), plain("ες")},
+		{circumflexed("η"), circumflexed("ων"), plain("εσι"), circumflexed("η"), circumflexed("η")},
+	}
+	nuStem = declension{
+		{plain("ων"), plain("ονος"), plain("ονῐ"), plain("ονᾰ"), {"ον", recessiveEnding, false}},
+		{plain("ονες"), plain("ονων"), plain("οσῐ"), plain("ονᾰς"), plain("ονες")},
+	}
+	nuStemNeuter = declension{
+		{plain("ον"), plain("ονος"), plain("ονῐ"), plain("ον"), plain("ον")},
+		{plain("ονᾰ"), plain("ονων"), plain("οσῐ"), plain("ονᾰ"), plain("ονᾰ")},
+	}
+	upsilonStem = declension{
+		{plain("ῠς"), plain("εος"), circumflexed("ει"), plain("ῠν"), {"ῠ", recessiveEnding, false}},
+		{circumflexed("εις"), plain("εων"), plain("εσῐ"), circumflexed("εις"), circumflexed("εις")},
+	}
+	upsilonStemNeuter = declension{
+		{plain("ῠ"), plain("εος"), circumflexed("ει"), plain("ῠ"), plain("ῠ")},
+		{plain("εᾰ"), plain("εων"), plain("εσῐ"), plain("εᾰ"), plain("εᾰ")},
+	}
+	// upsilonStemFeminine is of the first declension with a short α, and
+	// so has the genitive plural in -ῶν (ἡδειῶν).
+	upsilonStemFeminine = declension{
+		{plain("ειᾰ"), plain("ειᾱς"), plain("ειᾳ"), plain("ειᾰν"), plain("ειᾰ")},
+		{plain("ειαι"), {"ειων", perispomenonEnding, false}, plain("ειαις"), plain("ειᾱς"), plain("ειαι")},
+	}
+)
+
+// adjectiveTypes lists the kinds of adjective by the endings of the
+// masculine, feminine and neuter nominative singular.
+var adjectiveTypes = []struct {
+	endings     [3]string
+	declensions [3]declension
+}{
+	{[3]string{"ος", "η", "ον"}, [3]declension{secondMasculine, firstEta, secondNeuter}},
+	{[3]string{"ος", "α", "ον"}, [3]declension{secondMasculine, firstAlpha, secondNeuter}},
+	{[3]string{"ος", "ος", "ον"}, [3]declension{secondMasculine, secondMasculine, secondNeuter}},
+	{[3]string{"ης", "ης", "ες"}, [3]declension{sigmaStem, sigmaStem, sigmaStemNeuter}},
+	{[3]string{"ων", "ων", "ον"}, [3]declension{nuStem, nuStem, nuStemNeuter}},
+	{[3]string{"υς", "εια", "υ"}, [3]declension{upsilonStem, upsilonStemFeminine, upsilonStemNeuter}},
+}
+
+// ParadigmForm is one form of a paradigm.
+type ParadigmForm struct {
+	Gender Gender
+	Case   Case
+	Number Number
+	Form   string
+}
+
+// Paradigm holds the singular and plural forms of an adjective in each
+// gender.
+type Paradigm struct {
+	forms [3][2][5]string
+}
+
+// Form returns the form for a gender, case and number.
+func (p Paradigm) Form(g Gender, c Case, n Number) string {
+	return p.forms[g][n][c]
+}
+
+// Forms returns every form, by gender, then number, then case.
+func (p Paradigm) Forms() []ParadigmForm {
+	var result []ParadigmForm
+	for g := MASCULINE; g <= NEUTER; g++ {
+		for n := SINGULAR; n <= PLURAL; n++ {
+			for c := NOMINATIVE; c <= VOCATIVE; c++ {
+				result = append(result, ParadigmForm{g, c, n, p.forms[g][n][c]})
+			}
+		}
+	}
+	return result
+}
+
+// AdjectiveParadigm declines an adjective given by its accented masculine,
+// feminine and neuter nominative singular, with the feminine the same as
+// the masculine for an adjective of two endings. The adjectives of the
+// first and second declensions in -ος -η -ον, -ος -α -ον and -ος -ον, and
+// of the third declension in -ης -ες, -ων -ον and -υς -εια -υ are known.
+//
+// The accent is persistent, as for nouns, with a circumflex on a long
+// ultima in the genitive and dative of the second declension (καλοῦ) and
+// on contracted endings (ἀληθοῦς). The feminine nominative, vocative and
+// genitive plural of adjectives of the first and second declensions are
+// accented as the masculine (ἄξιαι, δικαίων, not δικαιῶν), but the first
+// declension feminine of ἡδύς has ἡδειῶν.
+func AdjectiveParadigm(masc, fem, neut string) (Paradigm, error) {
+	var result Paradigm
+	lemmas := [3]string{norm.NFC.String(masc), norm.NFC.String(fem), norm.NFC.String(neut)}
+	var bare [3]string
+	for i, l := range lemmas {
+		if getAccentuation(l) == NO_ACCENTUATION {
+			return result, fmt.Errorf("greekaccentuation: %q has no accent", l)
+		}
+		bare[i] = string(stripLength(StripAccents([]rune(l))))
+	}
+
+	for _, t := range adjectiveTypes {
+		stem := strings.TrimSuffix(bare[0], t.endings[0])
+		matches := stem != bare[0]
+		for i := range bare {
+			matches = matches && bare[i] == stem+t.endings[i]
+		}
+		if !matches {
+			continue
+		}
+		for g := range t.declensions {
+			for n, endings := range t.declensions[g] {
+				for c, e := range endings {
+					lemma := lemmas[g]
+					if e.masculine {
+						lemma = lemmas[MASCULINE]
+					}
+					result.forms[g][n][c] = declineForm(stem, e, lemma)
+				}
+			}
+		}
+		return result, nil
+	}
+	return result, fmt.Errorf("greekaccentuation: %s %s %s is not a known kind of adjective", masc, fem, neut)
+}
+
+// declineForm adds an ending to a stem and accents the form from the
+// nominative singular given.
+func declineForm(stem string, e ending, lemma string) string {
+	form := stem + e.text
+	s := Syllabify(form)
+	possible := PossibleAccentuations(form, Options{})
+	// The length marks of the endings are removed once the accent is
+	// placed
+	bare := func(w string) string {
+		return norm.NFC.String(string(stripLength([]rune(norm.NFC.String(w)))))
+	}
+	switch e.accent {
+	case recessiveEnding:
+		if getAccentuation(lemma) == OXYTONE {
+			return bare(addAccentuation(s, OXYTONE))
+		}
+		return bare(recessive(form, Options{}))
+	case perispomenonEnding:
+		if accentuationInSet(PERISPOMENON, possible) {
+			return bare(addAccentuation(s, PERISPOMENON))
+		}
+	}
+	w, _ := persistent(form, lemma, Options{}, nil)
+	w = bare(w)
+	if e.accent == circumflexEnding && getAccentuation(w) == OXYTONE && accentuationInSet(PERISPOMENON, possible) {
+		w = bare(addAccentuation(s, PERISPOMENON))
+	}
+	return w
+}
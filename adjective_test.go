@@ -0,0 +1,120 @@
+package greekaccentuation
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAdjectiveParadigm(t *testing.T) {
+	tests := []struct {
+		lemmas              [3]string
+		masculine, feminine string // singular then plural, nominative to vocative
+		neuter              string
+	}{
+		{
+			[3]string{"δίκαιος", "δικαία", "δίκαιον"},
+			"δίκαιος δικαίου δικαίῳ δίκαιον δίκαιε δίκαιοι δικαίων δικαίοις δικαίους δίκαιοι",
+			"δικαία δικαίας δικαίᾳ δικαίαν δικαία δίκαιαι δικαίων δικαίαις δικαίας δίκαιαι",
+			"δίκαιον δικαίου δικαίῳ δίκαιον δίκαιον δίκαια δικαίων δικαίοις δίκαια δίκαια",
+		},
+		{
+			[3]string{"ἄξιος", "ἀξία", "ἄξιον"},
+			"ἄξιος ἀξίου ἀξίῳ ἄξιον ἄξιε ἄξιοι ἀξίων ἀξίοις ἀξίους ἄξιοι",
+			"ἀξία ἀξίας ἀξίᾳ ἀξίαν ἀξία ἄξιαι ἀξίων ἀξίαις ἀξίας ἄξιαι",
+			"ἄξιον ἀξίου ἀξίῳ ἄξιον ἄξιον ἄξια ἀξίων ἀξίοις ἄξια ἄξια",
+		},
+		{
+			[3]string{"καλός", "καλή", "καλόν"},
+			"καλός καλοῦ καλῷ καλόν καλέ καλοί καλῶν καλοῖς καλούς καλοί",
+			"καλή καλῆς καλῇ καλήν καλή καλαί καλῶν καλαῖς καλάς καλαί",
+			"καλόν καλοῦ καλῷ καλόν καλόν καλά καλῶν καλοῖς καλά καλά",
+		},
+		{
+			[3]string{"μακρός", "μακρά", "μακρόν"},
+			"μακρός μακροῦ μακρῷ μακρόν μακρέ μακροί μακρῶν μακροῖς μακρούς μακροί",
+			"μακρά μακρᾶς μακρᾷ μακράν μακρά μακραί μακρῶν μακραῖς μακράς μακραί",
+			"μακρόν μακροῦ μακρῷ μακρόν μακρόν μακρά μακρῶν μακροῖς μακρά μακρά",
+		},
+		{
+			[3]string{"ἄδικος", "ἄδικος", "ἄδικον"},
+			"ἄδικος ἀδίκου ἀδίκῳ ἄδικον ἄδικε ἄδικοι ἀδίκων ἀδίκοις ἀδίκους ἄδικοι",
+			"ἄδικος ἀδίκου ἀδίκῳ ἄδικον ἄδικε ἄδικοι ἀδίκων ἀδίκοις ἀδίκους ἄδικοι",
+			"ἄδικον ἀδίκου ἀδίκῳ ἄδικον ἄδικον ἄδικα ἀδίκων ἀδίκοις ἄδικα ἄδικα",
+		},
+		{
+			[3]string{"ἀληθής", "ἀληθής", "ἀληθές"},
+			"ἀληθής ἀληθοῦς ἀληθεῖ ἀληθῆ ἀληθές ἀληθεῖς ἀληθῶν ἀληθέσι ἀληθεῖς ἀληθεῖς",
+			"ἀληθής ἀληθοῦς ἀληθεῖ ἀληθῆ ἀληθές ἀληθεῖς ἀληθῶν ἀληθέσι ἀληθεῖς ἀληθεῖς",
+			"ἀληθές ἀληθοῦς ἀληθεῖ ἀληθές ἀληθές ἀληθῆ ἀληθῶν ἀληθέσι ἀληθῆ ἀληθῆ",
+		},
+		{
+			[3]string{"αὐτάρκης", "αὐτάρκης", "αὔταρκες"},
+			"αὐτάρκης αὐτάρκους αὐτάρκει αὐτάρκη αὔταρκες αὐτάρκεις αὐτάρκων αὐτάρκεσι αὐτάρκεις αὐτάρκεις",
+			"αὐτάρκης αὐτάρκους αὐτάρκει αὐτάρκη αὔταρκες αὐτάρκεις αὐτάρκων αὐτάρκεσι αὐτάρκεις αὐτάρκεις",
+			"αὔταρκες αὐτάρκους αὐτάρκει αὔταρκες αὔταρκες αὐτάρκη αὐτάρκων αὐτάρκεσι αὐτάρκη αὐτάρκη",
+		},
+		{
+			[3]string{"εὐδαίμων", "εὐδαίμων", "εὔδαιμον"},
+			"εὐδαίμων εὐδαίμονος εὐδαίμονι εὐδαίμονα εὔδαιμον εὐδαίμονες εὐδαιμόνων εὐδαίμοσι εὐδαίμονας εὐδαίμονες",
+			"εὐδαίμων εὐδαίμονος εὐδαίμονι εὐδαίμονα εὔδαιμον εὐδαίμονες εὐδαιμόνων εὐδαίμοσι εὐδαίμονας εὐδαίμονες",
+			"εὔδαιμον εὐδαίμονος εὐδαίμονι εὔδαιμον εὔδαιμον εὐδαίμονα εὐδαιμόνων εὐδαίμοσι εὐδαίμονα εὐδαίμονα",
+		},
+		{
+			[3]string{"σώφρων", "σώφρων", "σῶφρον"},
+			"σώφρων σώφρονος σώφρονι σώφρονα σῶφρον σώφρονες σωφρόνων σώφροσι σώφρονας σώφρονες",
+			"σώφρων σώφρονος σώφρονι σώφρονα σῶφρον σώφρονες σωφρόνων σώφροσι σώφρονας σώφρονες",
+			"σῶφρον σώφρονος σώφρονι σῶφρον σῶφρον σώφρονα σωφρόνων σώφροσι σώφρονα σώφρονα",
+		},
+		{
+			[3]string{"ἡδύς", "ἡδεῖα", "ἡδύ"},
+			"ἡδύς ἡδέος ἡδεῖ ἡδύν ἡδύ ἡδεῖς ἡδέων ἡδέσι ἡδεῖς ἡδεῖς",
+			"ἡδεῖα ἡδείας ἡδείᾳ ἡδεῖαν ἡδεῖα ἡδεῖαι ἡδειῶν ἡδείαις ἡδείας ἡδεῖαι",
+			"ἡδύ ἡδέος ἡδεῖ ἡδύ ἡδύ ἡδέα ἡδέων ἡδέσι ἡδέα ἡδέα",
+		},
+	}
+	for _, test := range tests {
+		p, err := AdjectiveParadigm(test.lemmas[0], test.lemmas[1], test.lemmas[2])
+		if err != nil {
+			t.Fatalf("AdjectiveParadigm(%v) failed: %v", test.lemmas, err)
+		}
+		for g, expected := range []string{test.masculine, test.feminine, test.neuter} {
+			var got []string
+			for n := SINGULAR; n <= PLURAL; n++ {
+				for c := NOMINATIVE; c <= VOCATIVE; c++ {
+					got = append(got, p.Form(Gender(g), c, n))
+				}
+			}
+			if strings.Join(got, " ") != expected {
+				t.Errorf("AdjectiveParadigm(%v) %s = %s, expected %s",
+					test.lemmas, Gender(g).Name(), strings.Join(got, " "), expected)
+			}
+		}
+	}
+}
+
+func TestAdjectiveParadigmForms(t *testing.T) {
+	p, err := AdjectiveParadigm("δίκαιος", "δικαία", "δίκαιον")
+	if err != nil {
+		t.Fatal(err)
+	}
+	forms := p.Forms()
+	if len(forms) != 30 {
+		t.Fatalf("Forms() returned %d forms", len(forms))
+	}
+	f := forms[16]
+	if f.Gender != FEMININE || f.Number != PLURAL || f.Case != GENITIVE || f.Form != "δικαίων" {
+		t.Errorf("Forms()[16] = %+v", f)
+	}
+}
+
+func TestAdjectiveParadigmErrors(t *testing.T) {
+	for _, lemmas := range [][3]string{
+		{"δικαιος", "δικαια", "δικαιον"},
+		{"πᾶς", "πᾶσα", "πᾶν"},
+		{"δίκαιος", "καλή", "δίκαιον"},
+	} {
+		if _, err := AdjectiveParadigm(lemmas[0], lemmas[1], lemmas[2]); err == nil {
+			t.Errorf("AdjectiveParadigm(%v) should fail", lemmas)
+		}
+	}
+}
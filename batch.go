@@ -0,0 +1,164 @@
+package greekaccentuation
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// Pair is a single word form and the lemma whose accent it should follow.
+type Pair struct {
+	Word  string
+	Lemma string
+}
+
+// Result is the outcome of accenting a single Pair. Index is the
+// position of the pair in the input.
+type Result struct {
+	Index int
+	Pair  Pair
+	Form  string
+	Err   error
+}
+
+// BatchConfig controls a batch run. Workers defaults to the number of
+// CPUs. DefaultShort is passed through to Persistent.
+type BatchConfig struct {
+	Workers      int
+	DefaultShort bool
+}
+
+func (c BatchConfig) workers() int {
+	if c.Workers > 0 {
+		return c.Workers
+	}
+	return runtime.NumCPU()
+}
+
+// persistentResult runs Persistent on a single pair, reporting a lemma
+// with no accent as ErrNoAccent and an accent that cannot be placed as
+// ErrNoAccentuation.
+func persistentResult(index int, pair Pair, defaultShort bool) Result {
+	form, err := persistent(pair.Word, pair.Lemma, persistentOptions(defaultShort), nil)
+	return Result{Index: index, Pair: pair, Form: form, Err: err}
+}
+
+// BatchPersistent runs Persistent over every pair read from the pairs
+// channel on a pool of workers. Results are delivered in input order.
+// The returned channel is closed once the input channel is closed and
+// all results have been delivered, or as soon as ctx is cancelled, in
+// which case the remaining pairs are not reported.
+func BatchPersistent(ctx context.Context, pairs <-chan Pair, config BatchConfig) <-chan Result {
+	type job struct {
+		index int
+		pair  Pair
+	}
+	workers := config.workers()
+
+	jobs := make(chan job)
+	done := make(chan Result)
+	out := make(chan Result)
+	// window bounds how far results may run ahead of the oldest
+	// outstanding pair, so reordering does not buffer without limit.
+	window := make(chan struct{}, workers*4)
+
+	go func() {
+		defer close(jobs)
+		for i := 0; ; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case window <- struct{}{}:
+			}
+			var p Pair
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case p, ok = <-pairs:
+				if !ok {
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- job{i, p}:
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				r := persistentResult(j.index, j.pair, config.DefaultShort)
+				select {
+				case <-ctx.Done():
+					return
+				case done <- r:
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	go func() {
+		defer close(out)
+		pending := map[int]Result{}
+		next := 0
+		for r := range done {
+			pending[r.Index] = r
+			for {
+				p, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				select {
+				case <-ctx.Done():
+					return
+				case out <- p:
+				}
+				<-window
+				next++
+			}
+		}
+	}()
+
+	return out
+}
+
+// BatchPersistentSlice runs Persistent over a slice of pairs. The
+// results are returned in the same order as pairs. If ctx is cancelled
+// before all pairs are processed the results gathered so far are
+// returned along with the context error.
+func BatchPersistentSlice(ctx context.Context, pairs []Pair, config BatchConfig) ([]Result, error) {
+	in := make(chan Pair)
+	go func() {
+		defer close(in)
+		for _, p := range pairs {
+			select {
+			case <-ctx.Done():
+				return
+			case in <- p:
+			}
+		}
+	}()
+
+	results := make([]Result, 0, len(pairs))
+	for r := range BatchPersistent(ctx, in, config) {
+		results = append(results, r)
+	}
+	if len(results) < len(pairs) {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,104 @@
+package greekaccentuation
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+var batchPairs = []Pair{
+	{"ἀνθρωπος", "ἄνθρωπος"},
+	{"ἀνθρωπου", "ἄνθρωπος"},
+	{"καταβαινον", "καταβαίνων"},
+	{"Ααρων", "Ααρων"},
+	{"Ἰαννης", "Ἰάννης"},
+	{"περιπατει", "περιπατῶ"},
+	{"περιπατεις", "περιπατῶ"},
+}
+
+func TestBatchPersistentSlice(t *testing.T) {
+	var pairs []Pair
+	for i := 0; i < 50; i++ {
+		pairs = append(pairs, batchPairs...)
+	}
+	results, err := BatchPersistentSlice(context.Background(), pairs, BatchConfig{Workers: 4})
+	if err != nil {
+		t.Fatalf("BatchPersistentSlice() failed: %v", err)
+	}
+	if len(results) != len(pairs) {
+		t.Fatalf("BatchPersistentSlice() failed. Returned %d results", len(results))
+	}
+	for i, r := range results {
+		if r.Index != i || r.Pair != pairs[i] {
+			t.Fatalf("BatchPersistentSlice() failed. Result %d out of order: %v", i, r)
+		}
+		expected := Persistent(pairs[i].Word, pairs[i].Lemma, false)
+		if r.Form != expected {
+			t.Fatalf("BatchPersistentSlice() failed. Returned %s, expected %s", r.Form, expected)
+		}
+		if expected == "" && r.Err != ErrNoAccent {
+			t.Fatalf("BatchPersistentSlice() failed. Expected ErrNoAccent for %v", r.Pair)
+		}
+		if expected != "" && r.Err != nil {
+			t.Fatalf("BatchPersistentSlice() failed. Unexpected error %v", r.Err)
+		}
+	}
+}
+
+func TestBatchPersistentErrors(t *testing.T) {
+	pairs := []Pair{{"λος", "καταλαμβάνω"}, {"Ααρων", "Ααρων"}}
+	results, err := BatchPersistentSlice(context.Background(), pairs, BatchConfig{})
+	if err != nil {
+		t.Fatalf("BatchPersistentSlice() failed: %v", err)
+	}
+	if len(results) != 2 || results[0].Err != ErrNoAccentuation || results[1].Err != ErrNoAccent {
+		t.Fatalf("BatchPersistentSlice() failed. Expected errors, got %v", results)
+	}
+}
+
+func TestBatchPersistentCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Pair)
+	out := BatchPersistent(ctx, in, BatchConfig{Workers: 2})
+	in <- batchPairs[0]
+	r := <-out
+	if r.Form != "ἄνθρωπος" {
+		t.Fatalf("BatchPersistent() failed. Returned %s", r.Form)
+	}
+	cancel()
+	for range out {
+	}
+
+	results, err := BatchPersistentSlice(ctx, batchPairs, BatchConfig{})
+	if err != context.Canceled {
+		t.Fatalf("BatchPersistentSlice() failed. Expected context.Canceled, got %v (%d results)", err, len(results))
+	}
+}
+
+// TestConcurrentUse exercises the package level state from many goroutines.
+// Run with -race.
+func TestConcurrentUse(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, p := range batchPairs {
+				Persistent(p.Word, p.Lemma, false)
+				Recessive(p.Word, true, false)
+				Rebreath(p.Word)
+				for _, ch := range p.Lemma {
+					breathing(ch)
+					accent(ch)
+					length(ch)
+					iotaSubscript(ch)
+					diaeresis(ch)
+				}
+				StripAccents([]rune(p.Lemma))
+				stripBreathing([]rune(p.Lemma))
+				stripLength([]rune(p.Lemma))
+			}
+		}()
+	}
+	wg.Wait()
+}
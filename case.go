@@ -0,0 +1,142 @@
+package greekaccentuation
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// clusters splits text into letters, each a base character followed by
+// its combining marks in canonical order.
+func clusters(text string) [][]rune {
+	var result [][]rune
+	for _, ch := range norm.NFD.String(text) {
+		if isCombiningMark(ch) && len(result) > 0 {
+			last := len(result) - 1
+			result[last] = append(result[last], ch)
+			continue
+		}
+		result = append(result, []rune{ch})
+	}
+	return result
+}
+
+// composeLetter builds a letter from a base character and marks.
+func composeLetter(base rune, marks []rune) []rune {
+	letter := []rune{base}
+	for _, m := range marks {
+		letter = AddDiacritic(letter, m)
+	}
+	return letter
+}
+
+// hasMark reports whether a letter carries a mark found by the
+// extractor.
+func hasMark(letter []rune, extract ExtractDiacriticFunction) bool {
+	for _, m := range letter[1:] {
+		if extract(m) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// falseDiphthong reports whether two letters that could be read as a
+// diphthong are shown to be two syllables by an accent or breathing on
+// the first, where a diphthong would carry it on the second (ἄυλος).
+func falseDiphthong(previous, letter []rune) bool {
+	if !isDipthong(previous[0], letter[0]) || hasMark(letter, breathing) {
+		return false
+	}
+	return hasMark(previous, breathing) || (hasMark(previous, accent) && !hasMark(letter, accent))
+}
+
+// AddDiaeresisIfNeeded adds a diaeresis to the second of two vowels that
+// an accent or breathing on the first shows are not a diphthong, so that
+// the word still reads correctly without its accents (ἄυλος → ἄϋλος,
+// ἀυτμή → ἀϋτμή). An iota adscript is left alone.
+func AddDiaeresisIfNeeded(word string) string {
+	letters := clusters(word)
+	adscript := adscripts([]rune(norm.NFD.String(word)))
+	var b strings.Builder
+	offset := 0
+	for i, letter := range letters {
+		if i > 0 && !adscript[offset] && !hasMark(letter, diaeresis) && falseDiphthong(letters[i-1], letter) {
+			// The diaeresis goes before the accent, as in ΐ
+			l := Analyze([]rune(norm.NFC.String(string(letter)))[0])
+			l.Diaeresis = true
+			b.WriteString(l.String())
+		} else {
+			b.WriteString(string(letter))
+		}
+		offset += len(letter)
+	}
+	return norm.NFC.String(b.String())
+}
+
+// ToUpper converts Greek text to capitals as editions print them, without
+// accents, breathings or subscripts. An iota subscript becomes a capital
+// iota (τῷ → ΤΩΙ). A diaeresis is kept, and added where the lower case
+// showed by an accent or breathing on the first vowel that two vowels
+// are not a diphthong (ἀϋπνία → ΑΫΠΝΙΑ, ἄυλος → ΑΫΛΟΣ).
+func ToUpper(text string) string {
+	text = ToAdscript(text)
+	letters := clusters(text)
+	adscript := adscripts([]rune(norm.NFD.String(text)))
+	var b strings.Builder
+	offset := 0
+	for i, letter := range letters {
+		base := letter[0]
+		keep := hasMark(letter, diaeresis)
+		if !keep && i > 0 && !adscript[offset] {
+			keep = falseDiphthong(letters[i-1], letter)
+		}
+		offset += len(letter)
+		if keep {
+			b.WriteString(string(composeLetter(unicode.ToUpper(base), []rune{DIAERESIS.Rune()})))
+		} else {
+			b.WriteRune(unicode.ToUpper(base))
+		}
+	}
+	return b.String()
+}
+
+// ToLower converts Greek text to lower case keeping its diacritics, and
+// writes a sigma that ends a word as ς. A sigma before an elision mark
+// stays σ (σ᾽ for σε).
+func ToLower(text string) string {
+	r := []rune(norm.NFC.String(strings.ToLower(text)))
+	for i, ch := range r {
+		if ch != 'σ' || i == 0 || !unicode.IsLetter(r[i-1]) {
+			continue
+		}
+		j := i + 1
+		for j < len(r) && isCombiningMark(r[j]) {
+			j++
+		}
+		if j == len(r) || (!unicode.IsLetter(r[j]) && !IsElisionMark(r[j])) {
+			r[i] = 'ς'
+		}
+	}
+	return string(r)
+}
+
+// ToTitle converts each word of Greek text to lower case with an initial
+// capital that keeps its breathing and accent (ἄνθρωπος → Ἄνθρωπος). A
+// word that begins with a diphthong keeps them on its second vowel (Αἰ).
+func ToTitle(text string) string {
+	letters := clusters(ToLower(text))
+	var b strings.Builder
+	start := true
+	for _, letter := range letters {
+		base := letter[0]
+		if start && unicode.IsLetter(base) {
+			b.WriteString(string(composeLetter(unicode.ToUpper(base), letter[1:])))
+		} else {
+			b.WriteString(norm.NFC.String(string(letter)))
+		}
+		start = !unicode.IsLetter(base) && !IsElisionMark(base)
+	}
+	return norm.NFC.String(b.String())
+}
@@ -0,0 +1,78 @@
+package greekaccentuation
+
+import (
+	"testing"
+)
+
+func TestToUpper(t *testing.T) {
+	tests := map[string]string{
+		"ἄνθρωπος":       "ΑΝΘΡΩΠΟΣ",
+		"ἀϋπνία":         "ΑΫΠΝΙΑ",
+		"ἄυλος":          "ΑΫΛΟΣ",
+		"αὐτός":          "ΑΥΤΟΣ",
+		"καὶ":            "ΚΑΙ",
+		"τῷ λόγῳ":        "ΤΩΙ ΛΟΓΩΙ",
+		"τῶι":            "ΤΩΙ",
+		"προΐστημι":      "ΠΡΟΪΣΤΗΜΙ",
+		"Μωϋσῆς":         "ΜΩΫΣΗΣ",
+		"ᾍδης":           "ΑΙΔΗΣ",
+		"ῥήτωρ, ἀλλ᾽ οὐ": "ΡΗΤΩΡ, ΑΛΛ᾽ ΟΥ",
+	}
+	for text, expected := range tests {
+		if got := ToUpper(text); got != expected {
+			t.Errorf("ToUpper(%q) = %q, expected %q", text, got, expected)
+		}
+	}
+}
+
+func TestToLower(t *testing.T) {
+	tests := map[string]string{
+		"ΑΝΘΡΩΠΟΣ":        "ανθρωπος",
+		"ΛΟΓΟΣ ΚΑΙ ΣΟΦΙΑ": "λογος και σοφια",
+		"ΣΟΦΟΣ, ΣΟΦΟΣ.":   "σοφος, σοφος.",
+		"Ἄνθρωπος":        "ἄνθρωπος",
+		"Σ᾽ ΕΓΩ":          "σ᾽ εγω",
+		"ΟΔΥΣΣΕΥΣ":        "οδυσσευς",
+		"ᾼ":               "ᾳ",
+	}
+	for text, expected := range tests {
+		if got := ToLower(text); got != expected {
+			t.Errorf("ToLower(%q) = %q, expected %q", text, got, expected)
+		}
+	}
+}
+
+func TestToTitle(t *testing.T) {
+	tests := map[string]string{
+		"ἄνθρωπος":   "Ἄνθρωπος",
+		"αἰσχύλος":   "Αἰσχύλος",
+		"εὐριπίδης":  "Εὐριπίδης",
+		"ῥόδος":      "Ῥόδος",
+		"ᾅδης":       "ᾍδης",
+		"ΣΩΚΡΑΤΗΣ":   "Σωκρατης",
+		"ὁ ἄνθρωπος": "Ὁ Ἄνθρωπος",
+	}
+	for text, expected := range tests {
+		if got := ToTitle(text); got != expected {
+			t.Errorf("ToTitle(%q) = %q, expected %q", text, got, expected)
+		}
+	}
+}
+
+func TestAddDiaeresisIfNeeded(t *testing.T) {
+	tests := map[string]string{
+		"ἄυλος":  "ἄϋλος",
+		"ἀυτμή":  "ἀϋτμή",
+		"ἀϋπνία": "ἀϋπνία",
+		"αὐτός":  "αὐτός",
+		"καί":    "καί",
+		"τῶι":    "τῶι",
+		"ἆισαι":  "ἆισαι",
+		"ὀίομαι": "ὀΐομαι",
+	}
+	for word, expected := range tests {
+		if got := AddDiaeresisIfNeeded(word); got != expected {
+			t.Errorf("AddDiaeresisIfNeeded(%q) = %q, expected %q", word, got, expected)
+		}
+	}
+}
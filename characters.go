@@ -149,6 +149,8 @@ func (e Length) Name() string {
 		return "SHORT"
 	case LONG:
 		return "LONG"
+	case UNKNOWN:
+		return "UNKNOWN"
 	default:
 		return ""
 	}
@@ -158,12 +160,70 @@ func (e Length) Rune() rune {
 	return rune(e)
 }
 
-func Base(ch rune) rune {
+//go:generate go run gen_tables.go
+
+// decomposition holds the base character and combining marks of the
+// canonical (NFD) decomposition of a single character.
+type decomposition struct {
+	base  rune
+	marks []rune
+}
+
+// decompose returns the canonical decomposition of a character. Characters
+// in the Greek and Greek Extended blocks are looked up in precomputed
+// tables, everything else falls back to norm.NFD.
+func decompose(ch rune) (rune, []rune) {
+	switch {
+	case ch < 0xC0:
+		return ch, nil
+	case ch >= greekFirst && ch <= greekLast:
+		d := greekDecompositions[ch-greekFirst]
+		return d.base, d.marks
+	case ch >= greekExtendedFirst && ch <= greekExtendedLast:
+		d := greekExtendedDecompositions[ch-greekExtendedFirst]
+		return d.base, d.marks
+	}
 	characters := []rune(norm.NFD.String(string([]rune{ch})))
-	if len(characters) > 0 {
-		return characters[0]
+	if len(characters) == 0 {
+		return 0, nil
 	}
-	return 0
+	return characters[0], characters[1:]
+}
+
+// compose returns the NFC form of a decomposed character sequence.
+// Returns false if the sequence does not compose to a single character
+// found in the precomputed tables.
+func compose(decomposed []rune) (rune, bool) {
+	if len(decomposed) == 1 && decomposed[0] < 0xC0 {
+		return decomposed[0], true
+	}
+	ch, ok := greekCompositions[string(decomposed)]
+	return ch, ok
+}
+
+// isKnownDiacritic returns true if ch is one of the combining marks
+// the package works with.
+func isKnownDiacritic(ch rune) bool {
+	switch ch {
+	case rune(GRAVE), rune(ACUTE), rune(LONG), rune(SHORT), rune(DIAERESIS),
+		rune(SMOOTH), rune(ROUGH), rune(CIRCUMFLEX), rune(IOTA):
+		return true
+	}
+	return false
+}
+
+// isTableRune returns true if ch can be decomposed and recomposed
+// using only the precomputed tables.
+func isTableRune(ch rune) bool {
+	return ch < 0x80 ||
+		(ch >= greekFirst && ch <= greekLast) ||
+		(ch >= greekExtendedFirst && ch <= greekExtendedLast) ||
+		isKnownDiacritic(ch)
+}
+
+func Base(ch rune) rune {
+	b, _ := decompose(ch)
+	return b
 }
 
 type ExtractDiacriticFunction func(ch rune) RuneInterface
@@ -174,9 +234,9 @@ type ExtractDiacriticFunction func(ch rune) RuneInterface
 //func ExtractDiacritic(Enum, unknownValue=None) ExtractDiacriticFunction {
 func extractDiacritic(diacritics []RuneInterface, unknownValue RuneInterface) ExtractDiacriticFunction {
 	return func(ch rune) RuneInterface {
-		decomposedForm := []rune(norm.NFD.String(string([]rune{ch})))
+		b, marks := decompose(ch)
 		for _, diacritic := range diacritics {
-			if runeInArray(diacritic.Rune(), decomposedForm) {
+			if b == diacritic.Rune() || runeInArray(diacritic.Rune(), marks) {
 				return diacritic
 			}
 		}
@@ -191,14 +251,17 @@ func AddDiacritic(base []rune, diacritic rune) []rune {
 
 // AddBreathing attaches the specified breathing to a character
 func AddBreathing(ch rune, breathing Breathing) rune {
-	decomposed := []rune(norm.NFD.String(string([]rune{ch})))
-	if len(decomposed) > 1 && decomposed[1] == LONG.Rune() {
-		d := append([]rune{decomposed[0], decomposed[1], breathing.Rune()}, decomposed[2:]...)
-		return []rune(norm.NFC.String(string(d)))[0]
+	b, marks := decompose(ch)
+	var d []rune
+	if len(marks) > 0 && marks[0] == LONG.Rune() {
+		d = append([]rune{b, marks[0], breathing.Rune()}, marks[1:]...)
 	} else {
-		d := append([]rune{decomposed[0], breathing.Rune()}, decomposed[1:]...)
-		return []rune(norm.NFC.String(string(d)))[0]
+		d = append([]rune{b, breathing.Rune()}, marks...)
 	}
+	if c, ok := compose(d); ok {
+		return c
+	}
+	return []rune(norm.NFC.String(string(d)))[0]
 }
 
 type RemoveDiacriticFunction func(text []rune) []rune
@@ -206,25 +269,73 @@ type RemoveDiacriticFunction func(text []rune) []rune
 // Given an Enum of Unicode diacritics, return a function that takes a
 // string and returns the string without those diacritics.
 func removeDiacritic(diacritics []RuneInterface) RemoveDiacriticFunction {
+	skip := func(ch rune) bool {
+		for _, d := range diacritics {
+			if d.Rune() == ch {
+				return true
+			}
+		}
+		return false
+	}
 	return func(text []rune) []rune {
-		before := []rune(norm.NFD.String(string(text)))
-		after := []rune{}
-		for _, ch := range before {
-			skip := false
-			for _, d := range diacritics {
-				if d.Rune() == ch {
-					skip = true
-				}
+		for _, ch := range text {
+			if !isTableRune(ch) {
+				return removeDiacriticNorm(text, skip)
 			}
-			if !skip {
-				after = append(after, ch)
+		}
+
+		// Decompose, filter and recompose each character cluster (a
+		// starter followed by its combining marks) using the tables.
+		after := make([]rune, 0, len(text))
+		cluster := make([]rune, 0, 4)
+		flush := func() {
+			if len(cluster) == 0 {
+				return
 			}
+			if c, ok := compose(cluster); ok {
+				after = append(after, c)
+			} else {
+				after = append(after, []rune(norm.NFC.String(string(cluster)))...)
+			}
+			cluster = cluster[:0]
 		}
+		for _, ch := range text {
+			b, marks := decompose(ch)
+			if !isKnownDiacritic(b) {
+				flush()
+			}
+			if !skip(b) {
+				cluster = append(cluster, b)
+			}
+			for _, m := range marks {
+				if !skip(m) {
+					cluster = append(cluster, m)
+				}
+			}
+		}
+		flush()
+		return after
+	}
+}
 
-		return []rune(norm.NFC.String(string(after)))
+// removeDiacriticNorm removes diacritics from text that contains
+// characters not covered by the precomputed tables.
+func removeDiacriticNorm(text []rune, skip func(rune) bool) []rune {
+	before := []rune(norm.NFD.String(string(text)))
+	after := []rune{}
+	for _, ch := range before {
+		if !skip(ch) {
+			after = append(after, ch)
+		}
 	}
+
+	return []rune(norm.NFC.String(string(after)))
 }
 
+// The extractor and remover functions below, and the tables they read,
+// are created once at package initialisation and never modified, so they
+// are safe for concurrent use. The exported Breathings, Accents,
+// Diacritics, Subscripts and Lengths slices must not be modified.
 var breathing = extractDiacritic(Breathings, nil)
 var stripBreathing = removeDiacritic(Breathings)
 
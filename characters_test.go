@@ -1,6 +1,10 @@
 package greekaccentuation
 
-import "testing"
+import (
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
 
 func TestBase(t *testing.T) {
 	if Base('ᾳ') != 'α' {
@@ -62,3 +66,66 @@ func TestRecessive(t *testing.T) {
 			Recessive("εἰσηλθον", true, false))
 	}
 }
+
+func TestDecompositionTables(t *testing.T) {
+	for _, r := range [][2]rune{{greekFirst, greekLast}, {greekExtendedFirst, greekExtendedLast}} {
+		for ch := r[0]; ch <= r[1]; ch++ {
+			expected := []rune(norm.NFD.String(string(ch)))
+			b, marks := decompose(ch)
+			if !RuneArrayEqual(append([]rune{b}, marks...), expected) {
+				t.Fatalf("decompose(%U) failed. Returned %v, expected %v", ch, append([]rune{b}, marks...), expected)
+			}
+			composed := []rune(norm.NFC.String(string(expected)))
+			if c, ok := compose(expected); ok && (len(composed) != 1 || c != composed[0]) {
+				t.Fatalf("compose(%U) failed. Returned %U, expected %v", ch, c, composed)
+			}
+		}
+	}
+}
+
+func TestDiacriticFunctionsMatchNorm(t *testing.T) {
+	for _, r := range [][2]rune{{0x0020, 0x007F}, {greekFirst, greekLast}, {greekExtendedFirst, greekExtendedLast}} {
+		for ch := r[0]; ch <= r[1]; ch++ {
+			decomposed := []rune(norm.NFD.String(string(ch)))
+			if Base(ch) != decomposed[0] {
+				t.Fatalf("Base(%U) failed. Returned %U", ch, Base(ch))
+			}
+			for _, b := range []Breathing{SMOOTH, ROUGH} {
+				var d []rune
+				if len(decomposed) > 1 && decomposed[1] == LONG.Rune() {
+					d = append([]rune{decomposed[0], decomposed[1], b.Rune()}, decomposed[2:]...)
+				} else {
+					d = append([]rune{decomposed[0], b.Rune()}, decomposed[1:]...)
+				}
+				if AddBreathing(ch, b) != []rune(norm.NFC.String(string(d)))[0] {
+					t.Fatalf("AddBreathing(%U) failed. Returned %U", ch, AddBreathing(ch, b))
+				}
+			}
+			for _, diacritics := range [][]RuneInterface{Accents, Breathings, Lengths} {
+				text := []rune{'λ', ch, '́', 'ς'}
+				skip := func(c rune) bool {
+					for _, d := range diacritics {
+						if d.Rune() == c {
+							return true
+						}
+					}
+					return false
+				}
+				if string(removeDiacritic(diacritics)(text)) != string(removeDiacriticNorm(text, skip)) {
+					t.Fatalf("removeDiacritic(%U) failed. Returned %s", ch, string(removeDiacritic(diacritics)(text)))
+				}
+			}
+		}
+	}
+}
+
+func TestIsVowelDoesNotAllocate(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		IsVowel('ᾷ')
+		IsVowel('χ')
+		accent('ἄ')
+	})
+	if allocs != 0 {
+		t.Fatalf("IsVowel() allocates %v times", allocs)
+	}
+}
@@ -0,0 +1,167 @@
+package greekaccentuation
+
+import (
+	"bytes"
+	"sort"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Strength is the number of levels of difference a collation key
+// records.
+type Strength int
+
+const (
+	// PRIMARY compares the letters alone, ignoring diacritics and case.
+	PRIMARY Strength = 1
+	// SECONDARY also compares the breathings (none, smooth, rough), then
+	// the accents (none, acute, grave, circumflex), then the diaeresis,
+	// iota subscript and length marks.
+	SECONDARY Strength = 2
+	// TERTIARY also compares case, lower case first, and the variant
+	// letter forms such as ϐ.
+	TERTIARY Strength = 3
+)
+
+func (e Strength) Name() string {
+	switch e {
+	case PRIMARY:
+		return "PRIMARY"
+	case SECONDARY:
+		return "SECONDARY"
+	case TERTIARY:
+		return "TERTIARY"
+	}
+	return ""
+}
+
+// letterVariants maps the variant forms of letters to the usual form.
+// Final sigma is the usual form of σ at the end of a word, so it is not
+// a variant and collates as σ at every level.
+var letterVariants = map[rune]rune{
+	'ϐ': 'β', 'ϑ': 'θ', 'ϕ': 'φ', 'ϰ': 'κ', 'ϱ': 'ρ', 'ϖ': 'π', 'ϲ': 'σ', 'ϒ': 'Υ', 'Ϲ': 'Σ',
+}
+
+// collationLetter returns the primary letter of a base character and
+// whether it was a variant form.
+func collationLetter(base rune) (rune, bool) {
+	variant := false
+	if v, ok := letterVariants[base]; ok {
+		base, variant = v, true
+	}
+	base = unicode.ToLower(base)
+	if base == 'ς' {
+		base = 'σ'
+	}
+	return base, variant
+}
+
+// CollationKey returns a key for sorting Greek words in lexicon order:
+// keys compare with bytes.Compare as the words do at the given strength.
+// At the primary level diacritics and case are ignored, so ᾳ collates as
+// α and ς as σ.
+func CollationKey(word string, strength Strength) []byte {
+	var primary, breathings, accents, others, cases []byte
+
+	folded := stripLength(stripBreathing(StripAccents([]rune(norm.NFC.String(word)))))
+	for _, letter := range clusters(string(folded)) {
+		// The diaeresis and iota subscript are left as marks
+		base, _ := collationLetter(letter[0])
+		primary = append(primary, string(base)...)
+	}
+	if strength < SECONDARY {
+		return primary
+	}
+
+	for _, letter := range clusters(word) {
+		b, a, o := byte(1), byte(1), byte(1)
+		for _, m := range letter[1:] {
+			switch m {
+			case SMOOTH.Rune():
+				b = 2
+			case ROUGH.Rune():
+				b = 3
+			case ACUTE.Rune():
+				a = 2
+			case GRAVE.Rune():
+				a = 3
+			case CIRCUMFLEX.Rune():
+				a = 4
+			case DIAERESIS.Rune():
+				o |= 2
+			case IOTA.Rune():
+				o |= 4
+			case LONG.Rune():
+				o |= 8
+			case SHORT.Rune():
+				o |= 16
+			}
+		}
+		breathings = append(breathings, b)
+		accents = append(accents, a)
+		others = append(others, o)
+
+		c := byte(1)
+		if _, variant := collationLetter(letter[0]); variant {
+			c = 2
+		}
+		if unicode.IsUpper(letter[0]) {
+			c += 2
+		}
+		cases = append(cases, c)
+	}
+
+	key := append(primary, 0)
+	key = append(key, breathings...)
+	key = append(key, 0)
+	key = append(key, accents...)
+	key = append(key, 0)
+	key = append(key, others...)
+	if strength >= TERTIARY {
+		key = append(key, 0)
+		key = append(key, cases...)
+	}
+	return key
+}
+
+// Collator compares and sorts Greek words in lexicon order.
+type Collator struct {
+	Strength Strength
+}
+
+func NewCollator(strength Strength) *Collator {
+	return &Collator{Strength: strength}
+}
+
+// Key returns the collation key of a word.
+func (c *Collator) Key(word string) []byte {
+	return CollationKey(word, c.Strength)
+}
+
+// Compare returns -1, 0 or 1 as a sorts before, equal to or after b.
+func (c *Collator) Compare(a, b string) int {
+	return bytes.Compare(c.Key(a), c.Key(b))
+}
+
+type collationSort struct {
+	words []string
+	keys  [][]byte
+}
+
+func (s collationSort) Len() int { return len(s.words) }
+func (s collationSort) Swap(i, j int) {
+	s.words[i], s.words[j] = s.words[j], s.words[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+func (s collationSort) Less(i, j int) bool { return bytes.Compare(s.keys[i], s.keys[j]) < 0 }
+
+// Sort sorts words in place. Words that collate as equal keep their
+// order.
+func (c *Collator) Sort(words []string) {
+	s := collationSort{words, make([][]byte, len(words))}
+	for i, w := range words {
+		s.keys[i] = c.Key(w)
+	}
+	sort.Stable(s)
+}
@@ -0,0 +1,63 @@
+package greekaccentuation
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCollationKey(t *testing.T) {
+	equal := []struct {
+		a, b     string
+		strength Strength
+	}{
+		{"λόγος", "λογος", PRIMARY},
+		{"λόγος", "ΛΟΓΟΣ", PRIMARY},
+		{"ᾠδή", "ωδη", PRIMARY},
+		{"ϐίβλος", "βίβλος", PRIMARY},
+		{"ϐίβλος", "βίβλος", SECONDARY},
+		{"λόγος", "λόγοσ", TERTIARY},
+	}
+	for _, test := range equal {
+		if !bytes.Equal(CollationKey(test.a, test.strength), CollationKey(test.b, test.strength)) {
+			t.Errorf("CollationKey(%q) != CollationKey(%q) at %s", test.a, test.b, test.strength.Name())
+		}
+	}
+
+	ordered := []struct {
+		a, b     string
+		strength Strength
+	}{
+		{"ἀ", "ἁ", SECONDARY},
+		{"ὀρος", "ὁρος", SECONDARY},
+		{"ἁλς", "ἀλφα", SECONDARY},
+		{"ὁ", "ὅ", SECONDARY},
+		{"ὄ", "ὅ", SECONDARY},
+		{"ποσος", "πόσος", SECONDARY},
+		{"ποσός", "πόσος", SECONDARY},
+		{"τις", "τίς", SECONDARY},
+		{"ἀδω", "ᾄδω", SECONDARY},
+		{"ἐλπίς", "Ἐλπίς", TERTIARY},
+		{"βίβλος", "ϐίβλος", TERTIARY},
+		{"σοφός", "σοφώτερος", PRIMARY},
+	}
+	for _, test := range ordered {
+		if bytes.Compare(CollationKey(test.a, test.strength), CollationKey(test.b, test.strength)) >= 0 {
+			t.Errorf("CollationKey(%q) should sort before CollationKey(%q) at %s", test.a, test.b, test.strength.Name())
+		}
+	}
+}
+
+func TestCollatorSort(t *testing.T) {
+	words := []string{"ὅς", "ὄς", "Ὅς", "ὀρθός", "ὁ", "ὅρος", "ὄρος", "ὁράω", "ὀ", "οὐ", "ᾠδή", "ὤδε", "ὠδίς"}
+	NewCollator(TERTIARY).Sort(words)
+	expected := "ὀ ὁ ὁράω ὀρθός ὄρος ὅρος ὄς ὅς Ὅς οὐ ὤδε ᾠδή ὠδίς"
+	if strings.Join(words, " ") != expected {
+		t.Fatalf("Sort() failed. Returned %s", strings.Join(words, " "))
+	}
+
+	c := NewCollator(PRIMARY)
+	if c.Compare("λόγος", "λογός") != 0 || c.Compare("α", "β") != -1 || c.Compare("ω", "ψ") != 1 {
+		t.Fatalf("Compare() failed")
+	}
+}
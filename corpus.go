@@ -0,0 +1,239 @@
+package greekaccentuation
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// GRAVE_ACCENTUATION is the key used in a CorpusReport for words with a
+// grave on the ultima, which getAccentuation does not classify.
+const GRAVE_ACCENTUATION = "GRAVE"
+
+// CorpusReport holds the accent statistics gathered from a corpus.
+type CorpusReport struct {
+	Files int `json:"files"`
+	Words int `json:"words"`
+	// Accentuations counts words by the Name of their Accentuation, with
+	// grave bearing forms counted under GRAVE_ACCENTUATION.
+	Accentuations map[string]int `json:"accentuations"`
+	// SyllablesPerWord counts words by their number of syllables.
+	SyllablesPerWord map[int]int `json:"syllables_per_word"`
+	// UltimaLengths counts words by the Name of the Length of their
+	// final syllable, treating final -αι and -οι as short.
+	UltimaLengths map[string]int `json:"ultima_lengths"`
+	Syllables     int            `json:"syllables"`
+	// UnknownLengths counts syllables of unknown length.
+	UnknownLengths int `json:"unknown_lengths"`
+	// Flagged counts words that fail an accent check, by problem.
+	Flagged      map[string]int `json:"flagged"`
+	FlaggedWords int            `json:"flagged_words"`
+}
+
+// CorpusAnalyzer gathers accent statistics from a stream of text.
+type CorpusAnalyzer struct {
+	report CorpusReport
+}
+
+func NewCorpusAnalyzer() *CorpusAnalyzer {
+	return &CorpusAnalyzer{report: CorpusReport{
+		Accentuations:    map[string]int{},
+		SyllablesPerWord: map[int]int{},
+		UltimaLengths:    map[string]int{},
+		Flagged:          map[string]int{},
+	}}
+}
+
+// Report returns a copy of the statistics gathered so far, which later
+// calls to the analyzer do not change.
+func (c *CorpusAnalyzer) Report() CorpusReport {
+	r := c.report
+	r.Accentuations = copyCounts(c.report.Accentuations)
+	r.UltimaLengths = copyCounts(c.report.UltimaLengths)
+	r.Flagged = copyCounts(c.report.Flagged)
+	r.SyllablesPerWord = map[int]int{}
+	for k, v := range c.report.SyllablesPerWord {
+		r.SyllablesPerWord[k] = v
+	}
+	return r
+}
+
+func copyCounts(counts map[string]int) map[string]int {
+	result := map[string]int{}
+	for k, v := range counts {
+		result[k] = v
+	}
+	return result
+}
+
+// corpusWords splits a field of text into lower case Greek words,
+// dropping punctuation and elision marks.
+func corpusWords(field string) []string {
+	var words []string
+	for _, w := range strings.FieldsFunc(norm.NFC.String(field), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.Is(unicode.Mn, r)
+	}) {
+		for _, r := range w {
+			if unicode.Is(unicode.Greek, r) {
+				words = append(words, strings.ToLower(w))
+				break
+			}
+		}
+	}
+	return words
+}
+
+// Analyze reads text from r and adds its Greek words to the statistics.
+func (c *CorpusAnalyzer) Analyze(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+	for scanner.Scan() {
+		for _, w := range corpusWords(scanner.Text()) {
+			c.AddWord(w)
+		}
+	}
+	return scanner.Err()
+}
+
+// AnalyzeFiles adds the contents of each file to the statistics.
+func (c *CorpusAnalyzer) AnalyzeFiles(paths ...string) error {
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		err = c.Analyze(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		c.report.Files++
+	}
+	return nil
+}
+
+// AddWord adds a single word, normalized to NFC, to the statistics. An
+// empty word is ignored.
+func (c *CorpusAnalyzer) AddWord(word string) {
+	word = norm.NFC.String(word)
+	if word == "" {
+		return
+	}
+	s := Syllabify(word)
+	c.report.Words++
+
+	if syllableAccent(s[len(s)-1]) == GRAVE {
+		c.report.Accentuations[GRAVE_ACCENTUATION]++
+	} else {
+		c.report.Accentuations[getAccentuation(word).Name()]++
+	}
+	c.report.SyllablesPerWord[len(s)]++
+	c.report.UltimaLengths[syllableLength(s[len(s)-1], true).Name()]++
+	for _, syllable := range s {
+		c.report.Syllables++
+		if syllableLength(syllable) == UNKNOWN {
+			c.report.UnknownLengths++
+		}
+	}
+	if problem := accentProblem(word); problem != "" {
+		c.report.Flagged[problem]++
+		c.report.FlaggedWords++
+	}
+}
+
+// accentProblem checks the accent of a word and describes the first
+// problem found, or returns an empty string.
+func accentProblem(word string) string {
+	accents := 0
+	for _, ch := range word {
+		if accent(ch) != nil {
+			accents++
+		}
+	}
+	if accents == 0 {
+		return ""
+	}
+	s := Syllabify(word)
+	if accents > 1 {
+		// A proparoxytone or properispomenon takes a second accent, an
+		// acute on the ultima, from an enclitic after it (ἄνθρωπός τις,
+		// δῶρόν τι); the first accent is then checked as usual.
+		last := len(s) - 1
+		if accents > 2 || len(s) < 2 || syllableAccent(s[last]) != ACUTE {
+			return "more than one accent"
+		}
+		word = strings.Join(s[:last], "") + unaccented(s[last])
+		if a := getAccentuation(word); a != PROPAROXYTONE && a != PROPERISPOMENON {
+			return "more than one accent"
+		}
+		s = Syllabify(word)
+	}
+	for i, syllable := range s {
+		if syllableAccent(syllable) == GRAVE && i != len(s)-1 {
+			return "grave accent before the ultima"
+		}
+	}
+	if syllableAccent(s[len(s)-1]) == GRAVE {
+		return ""
+	}
+	a := getAccentuation(word)
+	if a == NO_ACCENTUATION {
+		return "accent before the antepenult"
+	}
+	if !accentuationInSet(a, possibleAccentuations(s, true, false)) {
+		return "violates the law of limitation"
+	}
+	return ""
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r CorpusReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteText writes the report as a table of plain text.
+func (r CorpusReport) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	percent := func(n, total int) string {
+		if total == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%.1f%%", 100*float64(n)/float64(total))
+	}
+	section := func(title string, counts map[string]int, total int) {
+		var keys []string
+		for k := range counts {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		fmt.Fprintf(tw, "%s\t\t\t\n", title)
+		for _, k := range keys {
+			fmt.Fprintf(tw, "\t%s\t%d\t%s\t\n", k, counts[k], percent(counts[k], total))
+		}
+	}
+
+	fmt.Fprintf(tw, "files\t\t%d\t\t\n", r.Files)
+	fmt.Fprintf(tw, "words\t\t%d\t\t\n", r.Words)
+	fmt.Fprintf(tw, "syllables\t\t%d\t\t\n", r.Syllables)
+	fmt.Fprintf(tw, "unknown lengths\t\t%d\t%s\t\n", r.UnknownLengths, percent(r.UnknownLengths, r.Syllables))
+	fmt.Fprintf(tw, "flagged words\t\t%d\t%s\t\n", r.FlaggedWords, percent(r.FlaggedWords, r.Words))
+	section("accentuation", r.Accentuations, r.Words)
+	section("ultima length", r.UltimaLengths, r.Words)
+	syllables := map[string]int{}
+	for n, count := range r.SyllablesPerWord {
+		syllables[fmt.Sprintf("%2d", n)] = count
+	}
+	section("syllables per word", syllables, r.Words)
+	section("flagged", r.Flagged, r.FlaggedWords)
+	return tw.Flush()
+}
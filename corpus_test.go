@@ -0,0 +1,132 @@
+package greekaccentuation
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestCorpusAnalyzer(t *testing.T) {
+	c := NewCorpusAnalyzer()
+	err := c.Analyze(strings.NewReader("Ἐν ἀρχῇ ἦν ὁ λόγος, καὶ ὁ λόγος ἦν πρὸς τὸν θεόν."))
+	if err != nil {
+		t.Fatalf("Analyze() failed: %v", err)
+	}
+	r := c.Report()
+	if r.Words != 12 {
+		t.Fatalf("Analyze() failed. Counted %d words", r.Words)
+	}
+	if r.Accentuations["GRAVE"] != 3 {
+		t.Fatalf("Analyze() failed. Counted %d grave forms", r.Accentuations["GRAVE"])
+	}
+	if r.Accentuations["NO_ACCENTUATION"] != 3 {
+		t.Fatalf("Analyze() failed. Counted %d unaccented forms", r.Accentuations["NO_ACCENTUATION"])
+	}
+	if r.Accentuations["PAROXYTONE"] != 2 || r.Accentuations["OXYTONE"] != 1 || r.Accentuations["PERISPOMENON"] != 3 {
+		t.Fatalf("Analyze() failed. Returned %v", r.Accentuations)
+	}
+	if r.SyllablesPerWord[1] != 8 || r.SyllablesPerWord[2] != 4 {
+		t.Fatalf("Analyze() failed. Returned %v", r.SyllablesPerWord)
+	}
+	if r.FlaggedWords != 0 {
+		t.Fatalf("Analyze() failed. Returned %v", r.Flagged)
+	}
+
+	// the report is a copy
+	r.Accentuations["GRAVE"] = 0
+	r.SyllablesPerWord[1] = 0
+	c.AddWord("λόγος")
+	if r2 := c.Report(); r2.Accentuations["GRAVE"] != 3 || r2.SyllablesPerWord[1] != 8 || r.Accentuations["PAROXYTONE"] != 2 {
+		t.Fatalf("Report() failed. Returned %v", r2)
+	}
+}
+
+func TestAddWord(t *testing.T) {
+	c := NewCorpusAnalyzer()
+	c.AddWord("")
+	c.AddWord(norm.NFD.String("λόγος"))
+	c.AddWord("λόγος")
+	r := c.Report()
+	if r.Words != 2 || r.Accentuations["PAROXYTONE"] != 2 || r.SyllablesPerWord[2] != 2 {
+		t.Fatalf("AddWord() failed. Returned %+v", r)
+	}
+	if r.FlaggedWords != 0 {
+		t.Fatalf("AddWord() flagged %v", r.Flagged)
+	}
+}
+
+func TestAccentProblem(t *testing.T) {
+	if accentProblem("ἄνθρωπος") != "" {
+		t.Fatal("accentProblem() failed")
+	}
+	if accentProblem("ἄνθρωπου") != "violates the law of limitation" {
+		t.Fatalf("accentProblem() failed. Returned %s", accentProblem("ἄνθρωπου"))
+	}
+	if accentProblem("ὰνθρωπος") != "grave accent before the ultima" {
+		t.Fatalf("accentProblem() failed. Returned %s", accentProblem("ὰνθρωπος"))
+	}
+	// the second accent of a word before an enclitic
+	for _, w := range []string{"ἄνθρωπός", "δῶρόν", "σῶσόν"} {
+		if accentProblem(w) != "" {
+			t.Fatalf("accentProblem(%q) failed. Returned %s", w, accentProblem(w))
+		}
+	}
+	for _, w := range []string{"λόγός", "ἄνθρώπος", "ἄνθρωπὸς"} {
+		if accentProblem(w) != "more than one accent" {
+			t.Fatalf("accentProblem(%q) failed. Returned %s", w, accentProblem(w))
+		}
+	}
+	if accentProblem("κάταλαμβανω") != "accent before the antepenult" {
+		t.Fatalf("accentProblem() failed. Returned %s", accentProblem("κάταλαμβανω"))
+	}
+}
+
+func TestCorpusAnalyzerFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "corpus")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a := filepath.Join(dir, "a.txt")
+	b := filepath.Join(dir, "b.txt")
+	ioutil.WriteFile(a, []byte("ἄνθρωπος ἀνθρώπου"), 0644)
+	ioutil.WriteFile(b, []byte("ἄνθρωπου"), 0644)
+
+	c := NewCorpusAnalyzer()
+	if err := c.AnalyzeFiles(a, b); err != nil {
+		t.Fatalf("AnalyzeFiles() failed: %v", err)
+	}
+	r := c.Report()
+	if r.Files != 2 || r.Words != 3 || r.FlaggedWords != 1 {
+		t.Fatalf("AnalyzeFiles() failed. Returned %+v", r)
+	}
+	if r.UltimaLengths["SHORT"] != 1 || r.UltimaLengths["LONG"] != 2 {
+		t.Fatalf("AnalyzeFiles() failed. Returned %v", r.UltimaLengths)
+	}
+	if err := c.AnalyzeFiles(filepath.Join(dir, "missing.txt")); err == nil {
+		t.Fatal("AnalyzeFiles() failed. Expected an error")
+	}
+
+	var buf bytes.Buffer
+	if err := r.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON() failed: %v", err)
+	}
+	var decoded CorpusReport
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil || decoded.Words != 3 {
+		t.Fatalf("WriteJSON() failed. Returned %s", buf.String())
+	}
+
+	buf.Reset()
+	if err := r.WriteText(&buf); err != nil {
+		t.Fatalf("WriteText() failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "violates the law of limitation") {
+		t.Fatalf("WriteText() failed. Returned %s", buf.String())
+	}
+}
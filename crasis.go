@@ -0,0 +1,212 @@
+package greekaccentuation
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// KORONIS marks crasis. U+0343 COMBINING GREEK KORONIS is canonically
+// equivalent to the smooth breathing, so in normalized text a koronis is
+// a smooth breathing on a vowel inside the word: κἀγώ.
+const KORONIS = SMOOTH
+
+// Crasis is a crasis form with the words it is made of.
+type Crasis struct {
+	Form   string
+	First  string
+	Second string
+	// Guessed is true if the form is not in the crasis table and the
+	// words were reconstructed from its spelling.
+	Guessed bool
+}
+
+var crases = struct {
+	sync.RWMutex
+	forms map[string]Crasis
+}{forms: map[string]Crasis{}}
+
+// crasisKey returns the key a crasis form is stored under: the form in
+// lower case, with its breathings but without accents.
+func crasisKey(form string) string {
+	return strings.ToLower(unaccented(normalizeKoronis(form)))
+}
+
+// RegisterCrasis adds a crasis form and the two words it is made of to
+// the table used by SplitCrasis. The words should be accented as they
+// stand alone.
+func RegisterCrasis(form, first, second string) {
+	form = normalizeKoronis(form)
+	crases.Lock()
+	defer crases.Unlock()
+	crases.forms[crasisKey(form)] = Crasis{Form: form, First: first, Second: second}
+}
+
+func init() {
+	for _, c := range [][3]string{
+		{"κἀγώ", "καί", "ἐγώ"},
+		{"κἀμοί", "καί", "ἐμοί"},
+		{"κἀκεῖνος", "καί", "ἐκεῖνος"},
+		{"κἀπί", "καί", "ἐπί"},
+		{"κᾆτα", "καί", "εἶτα"},
+		{"κοὐ", "καί", "οὐ"},
+		{"κοὐκ", "καί", "οὐκ"},
+		{"χὠ", "καί", "ὁ"},
+		{"χἠ", "καί", "ἡ"},
+		{"χοἰ", "καί", "οἱ"},
+		{"τἀληθῆ", "τά", "ἀληθῆ"},
+		{"τἀγαθά", "τά", "ἀγαθά"},
+		{"τἆλλα", "τά", "ἄλλα"},
+		{"ταὐτό", "τό", "αὐτό"},
+		{"ταὐτά", "τά", "αὐτά"},
+		{"τοὔνομα", "τό", "ὄνομα"},
+		{"τοὐναντίον", "τό", "ἐναντίον"},
+		{"θοἰμάτιον", "τό", "ἱμάτιον"},
+		{"θἠμέρᾳ", "τῇ", "ἡμέρᾳ"},
+		{"τἀνδρός", "τοῦ", "ἀνδρός"},
+		{"μοὐστί", "μοί", "ἐστί"},
+		{"προὔργου", "πρό", "ἔργου"},
+		{"ἁνήρ", "ὁ", "ἀνήρ"},
+		{"ὦνθρωπε", "ὦ", "ἄνθρωπε"},
+	} {
+		RegisterCrasis(c[0], c[1], c[2])
+	}
+}
+
+// normalizeKoronis puts a koronis written as a spacing mark between a
+// consonant and a vowel (κ᾽αγω, κ᾿αγω) onto the vowel, and returns the
+// word in NFC.
+func normalizeKoronis(word string) string {
+	r := []rune(norm.NFC.String(word))
+	var result []rune
+	for i := 0; i < len(r); i++ {
+		if (r[i] == '᾽' || r[i] == '᾿') && i > 0 && i+1 < len(r) &&
+			isConsonant(r[i-1]) && IsVowel(r[i+1]) && breathing(r[i+1]) == nil {
+			result = append(result, AddBreathing(r[i+1], KORONIS))
+			i++
+			continue
+		}
+		result = append(result, r[i])
+	}
+	return string(result)
+}
+
+// koronisIndex returns the index of the vowel in word that bears a
+// koronis, or -1. A koronis is a breathing on a vowel that follows a
+// consonant.
+func koronisIndex(word []rune) int {
+	for i, ch := range word {
+		if i > 0 && IsVowel(ch) && breathing(ch) != nil && isConsonant(word[i-1]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// hasKoronis reports whether a syllable bears a koronis: a breathing on
+// its nucleus after a consonant of its onset, which can only be inside a
+// word. onsetNucleusCoda moves the breathing of an initial vowel into
+// the onset, but a breathing after an initial capital vowel (Οἱ) stays
+// in the nucleus and is not a koronis.
+func hasKoronis(onset, nucleus []rune) bool {
+	consonant := false
+	for _, ch := range onset {
+		consonant = consonant || isConsonant(ch)
+	}
+	if !consonant {
+		return false
+	}
+	for _, ch := range nucleus {
+		if breathing(ch) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCrasis reports whether a word is a crasis, either because it has a
+// koronis or because it is in the crasis table.
+func IsCrasis(word string) bool {
+	word = normalizeKoronis(word)
+	if koronisIndex([]rune(word)) >= 0 {
+		return true
+	}
+	crases.RLock()
+	defer crases.RUnlock()
+	_, ok := crases.forms[crasisKey(word)]
+	return ok
+}
+
+// SplitCrasis returns the words a crasis is made of, for looking up
+// their lemmas. Forms in the crasis table are split exactly. Otherwise a
+// form with a koronis after κ or χ is read as καί, and after τ or θ as
+// the article, with the rest of the form as the second word; the vowel
+// of the second word may be wrong, so Guessed is set.
+func SplitCrasis(word string) (Crasis, bool) {
+	word = normalizeKoronis(word)
+	crases.RLock()
+	c, ok := crases.forms[crasisKey(word)]
+	crases.RUnlock()
+	if ok {
+		if r := []rune(word); unicode.IsUpper(r[0]) {
+			f := []rune(c.First)
+			f[0] = unicode.ToUpper(f[0])
+			c.First = string(f)
+		}
+		c.Form = word
+		return c, true
+	}
+
+	r := []rune(word)
+	i := koronisIndex(r)
+	if i != 1 {
+		return Crasis{}, false
+	}
+	first := ""
+	b := KORONIS
+	switch unicode.ToLower(r[0]) {
+	case 'κ':
+		first = "καί"
+	case 'χ':
+		first, b = "καί", ROUGH
+	case 'τ':
+		first = "τό"
+	case 'θ':
+		first, b = "τό", ROUGH
+	default:
+		return Crasis{}, false
+	}
+	if Base(r[1]) == 'α' {
+		first = strings.Replace(first, "ό", "ά", 1)
+	}
+	if unicode.IsUpper(r[0]) {
+		f := []rune(first)
+		f[0] = unicode.ToUpper(f[0])
+		first = string(f)
+	}
+	second := append([]rune{AddBreathing(stripBreathing(r[1:2])[0], b)}, r[2:]...)
+	return Crasis{Form: word, First: first, Second: string(second), Guessed: true}, true
+}
+
+// AccentCrasis accents a crasis from its second word: the crasis keeps
+// the accent of the second word on the same syllable counted from the
+// end, changed as the law of limitation requires, and the contracted
+// vowel under the koronis counts as long. If second is empty it is taken
+// from SplitCrasis. A crasis whose second word is unaccented, such as χὠ
+// from καὶ ὁ, stays unaccented.
+func AccentCrasis(word, second string, options Options) string {
+	word = unaccented(normalizeKoronis(word))
+	if second == "" {
+		c, ok := SplitCrasis(word)
+		if !ok {
+			return options.Form.String(word)
+		}
+		second = c.Second
+	}
+	if GetAccentuation(second) == NO_ACCENTUATION {
+		return options.Form.String(word)
+	}
+	return PersistentWith(word, second, options)
+}
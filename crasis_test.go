@@ -0,0 +1,86 @@
+package greekaccentuation
+
+import (
+	"testing"
+)
+
+func TestIsCrasis(t *testing.T) {
+	tests := map[string]bool{
+		"κἀγώ":     true,
+		"χὠ":       true,
+		"τἀληθῆ":   true,
+		"κ᾽αγω":    true,
+		"ἁνήρ":     true,
+		"ἀνήρ":     false,
+		"οὐκ":      false,
+		"Αἰσχύλος": false,
+		"θεός":     false,
+	}
+	for word, expected := range tests {
+		if IsCrasis(word) != expected {
+			t.Errorf("IsCrasis(%q) = %v, expected %v", word, !expected, expected)
+		}
+	}
+}
+
+func TestSplitCrasis(t *testing.T) {
+	tests := []struct {
+		word          string
+		first, second string
+		guessed       bool
+	}{
+		{"κἀγώ", "καί", "ἐγώ", false},
+		{"Κἀγώ", "Καί", "ἐγώ", false},
+		{"κ᾽αγω", "καί", "ἐγώ", false},
+		{"τοὔνομα", "τό", "ὄνομα", false},
+		{"χὠπως", "καί", "ὡπως", true},
+		{"τἀργύριον", "τά", "ἀργύριον", true},
+	}
+	for _, test := range tests {
+		c, ok := SplitCrasis(test.word)
+		if !ok || c.First != test.first || c.Second != test.second || c.Guessed != test.guessed {
+			t.Errorf("SplitCrasis(%q) = %+v, %v", test.word, c, ok)
+		}
+	}
+	if _, ok := SplitCrasis("λόγος"); ok {
+		t.Errorf("SplitCrasis() split a word that is not a crasis")
+	}
+}
+
+func TestAccentCrasis(t *testing.T) {
+	tests := []struct {
+		word, second string
+		options      Options
+		expected     string
+	}{
+		{"κἀγω", "ἐγώ", Options{}, "κἀγώ"},
+		{"κἀγω", "", Options{}, "κἀγώ"},
+		{"τἀληθη", "ἀληθῆ", Options{}, "τἀληθῆ"},
+		{"τἀλλα", "ἄλλα", Options{DefaultShort: true}, "τἆλλα"},
+		{"τοὐνομα", "ὄνομα", Options{}, "τοὔνομα"},
+		{"χὠ", "ὁ", Options{}, "χὠ"},
+		{"κᾀτα", "εἶτα", Options{}, "κᾆτα"},
+	}
+	for _, test := range tests {
+		if got := AccentCrasis(test.word, test.second, test.options); got != test.expected {
+			t.Errorf("AccentCrasis(%q, %q) = %q, expected %q", test.word, test.second, got, test.expected)
+		}
+	}
+}
+
+func TestCrasisLength(t *testing.T) {
+	if syllableLength("τἀ") != LONG {
+		t.Fatalf("syllableLength() failed. Returned %s", syllableLength("τἀ").Name())
+	}
+	if syllableLength("ἀ") != UNKNOWN {
+		t.Fatalf("syllableLength() failed. Returned %s", syllableLength("ἀ").Name())
+	}
+	// The breathing of an initial diphthong after a capital is not a
+	// koronis
+	if syllableLength("Οἱ", true) == LONG {
+		t.Fatalf("syllableLength() failed. Returned %s", syllableLength("Οἱ", true).Name())
+	}
+	if !hasKoronis([]rune("κ"), []rune("ἀ")) || hasKoronis([]rune("Ο"), []rune("ἱ")) {
+		t.Fatal("hasKoronis() failed")
+	}
+}
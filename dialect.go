@@ -0,0 +1,146 @@
+package greekaccentuation
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Dialect selects the accentuation rules of a dialect.
+type Dialect int
+
+const (
+	ATTIC  Dialect = 0 // Attic and Koine, the default
+	AEOLIC Dialect = 1 // Lesbian Aeolic: recessive accent and psilosis
+	DORIC  Dialect = 2
+	IONIC  Dialect = 3 // East Ionic: Attic accent with psilosis
+)
+
+func (e Dialect) Name() string {
+	switch e {
+	case ATTIC:
+		return "ATTIC"
+	case AEOLIC:
+		return "AEOLIC"
+	case DORIC:
+		return "DORIC"
+	case IONIC:
+		return "IONIC"
+	}
+	return ""
+}
+
+// psilotic reports whether the dialect has lost the rough breathing.
+func (e Dialect) psilotic() bool {
+	return e == AEOLIC || e == IONIC
+}
+
+// Inflection identifies a form that some dialects or declensions accent
+// differently.
+type Inflection int
+
+const (
+	NO_INFLECTION Inflection = 0
+	// THIRD_PLURAL is a third person plural of a past tense in -ον or
+	// -αν, which Doric accents on the penult (ἐλέγον, ἐλύσαν).
+	THIRD_PLURAL Inflection = 1
+	// GENITIVE_PLURAL is a genitive plural in -ων or -ᾱν, which Doric
+	// accents with a circumflex on the ultima (παιδῶν, Μοισᾶν).
+	GENITIVE_PLURAL Inflection = 2
+	// GENITIVE_SINGULAR, DATIVE_SINGULAR and DATIVE_PLURAL, with
+	// GENITIVE_PLURAL, are the cases in which a third declension
+	// monosyllable is accented on the ultima (φλεβός, φλεψί).
+	GENITIVE_SINGULAR Inflection = 3
+	DATIVE_SINGULAR   Inflection = 4
+	DATIVE_PLURAL     Inflection = 5
+)
+
+func (e Inflection) Name() string {
+	switch e {
+	case NO_INFLECTION:
+		return "NO_INFLECTION"
+	case THIRD_PLURAL:
+		return "THIRD_PLURAL"
+	case GENITIVE_PLURAL:
+		return "GENITIVE_PLURAL"
+	case GENITIVE_SINGULAR:
+		return "GENITIVE_SINGULAR"
+	case DATIVE_SINGULAR:
+		return "DATIVE_SINGULAR"
+	case DATIVE_PLURAL:
+		return "DATIVE_PLURAL"
+	}
+	return ""
+}
+
+// hasEnding reports whether a word ends in one of the endings, ignoring
+// diacritics and case.
+func hasEnding(word string, endings ...string) bool {
+	var b strings.Builder
+	for _, ch := range word {
+		if !isCombiningMark(ch) {
+			b.WriteRune(unicode.ToLower(Base(ch)))
+		}
+	}
+	plain := b.String()
+	for _, e := range endings {
+		if strings.HasSuffix(plain, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// recessiveAccentuation returns the accentuation furthest from the ultima.
+func recessiveAccentuation(possible []Accentuation) Accentuation {
+	if len(possible) == 0 {
+		return NO_ACCENTUATION
+	}
+	ll := append([]Accentuation{}, possible...)
+	sort.Sort(ByAccentReverse(ll))
+	return ll[0]
+}
+
+// dialectAccentuation returns the accentuation a dialect requires for a
+// noun or adjective, with the name of the rule, or an empty rule if the
+// Attic accent stands.
+func (o Options) dialectAccentuation(s []string, possible []Accentuation) (string, Accentuation) {
+	switch o.Dialect {
+	case AEOLIC:
+		if len(s) > 1 {
+			return "Aeolic recessive accent", recessiveAccentuation(possible)
+		}
+	case DORIC:
+		if o.Inflection == GENITIVE_PLURAL && hasEnding(s[len(s)-1], "ων", "αν") {
+			return "Doric genitive plural", PERISPOMENON
+		}
+	}
+	return "", NO_ACCENTUATION
+}
+
+// psilosis replaces a rough breathing on the initial vowel or diphthong
+// of a word with a smooth one. A rough breathing on ρ, or one inside the
+// word, is kept.
+func psilosis(word string) string {
+	r := []rune(norm.NFC.String(word))
+	for i := 0; i < len(r) && i < 2 && IsVowel(unicode.ToLower(Base(r[i]))); i++ {
+		if breathing(r[i]) == ROUGH {
+			r[i] = AddBreathing(stripBreathing([]rune{r[i]})[0], SMOOTH)
+			break
+		}
+	}
+	return string(r)
+}
+
+// RebreathWith is Rebreath for a dialect. The psilotic dialects, Aeolic
+// and Ionic, give an initial vowel a smooth breathing where Attic has a
+// rough one, as in Aeolic ὔμμες for ὑμεῖς.
+func RebreathWith(word string, options Options) string {
+	word = Rebreath(word)
+	if options.Dialect.psilotic() {
+		word = psilosis(word)
+	}
+	return word
+}
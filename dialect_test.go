@@ -0,0 +1,97 @@
+package greekaccentuation
+
+import (
+	"testing"
+)
+
+func TestDialectPersistent(t *testing.T) {
+	tests := []struct {
+		word, lemma string
+		options     Options
+		expected    string
+	}{
+		{"θεος", "θεός", Options{}, "θεός"},
+		{"θεος", "θεός", Options{Dialect: AEOLIC}, "θέος"},
+		{"ποταμος", "ποταμός", Options{Dialect: AEOLIC}, "πόταμος"},
+		{"σοφια", "σοφία", Options{Dialect: AEOLIC, DefaultShort: true}, "σόφια"},
+		{"Ζευς", "Ζεύς", Options{Dialect: AEOLIC}, "Ζεύς"},
+		{"ἀνθρωποι", "ἄνθρωπος", Options{Dialect: DORIC}, "ἀνθρώποι"},
+		{"παιδων", "παῖς", Options{Dialect: DORIC, Inflection: GENITIVE_PLURAL}, "παιδῶν"},
+		{"παιδων", "παῖς", Options{Dialect: ATTIC, Inflection: GENITIVE_PLURAL}, "παίδων"},
+	}
+	for _, test := range tests {
+		if got := PersistentWith(test.word, test.lemma, test.options); got != test.expected {
+			t.Errorf("PersistentWith(%q, %q, %s) = %q, expected %q",
+				test.word, test.lemma, test.options.Dialect.Name(), got, test.expected)
+		}
+	}
+}
+
+func TestDialectRecessive(t *testing.T) {
+	tests := []struct {
+		word     string
+		options  Options
+		expected string
+	}{
+		{"ἐλεγον", Options{}, "ἔλεγον"},
+		{"ἐλεγον", Options{Dialect: DORIC}, "ἔλεγον"},
+		{"ἐλεγον", Options{Dialect: DORIC, Inflection: THIRD_PLURAL}, "ἐλέγον"},
+		{"ἐλαβον", Options{Dialect: ATTIC, Inflection: THIRD_PLURAL}, "ἔλαβον"},
+		{"ἐλυσαν", Options{Dialect: DORIC, Inflection: THIRD_PLURAL, DefaultShort: true}, "ἐλύσαν"},
+		// a penult of unknown length takes the acute
+		{"ἐλυσαν", Options{Dialect: DORIC, Inflection: THIRD_PLURAL}, "ἐλύσαν"},
+		{"ἐλειπον", Options{Dialect: DORIC, Inflection: THIRD_PLURAL}, "ἐλεῖπον"},
+	}
+	for _, test := range tests {
+		if got := RecessiveWith(test.word, test.options); got != test.expected {
+			t.Errorf("RecessiveWith(%q, %s) = %q, expected %q",
+				test.word, test.options.Dialect.Name(), got, test.expected)
+		}
+	}
+	if Recessive("ἐλεγον", true, false) != RecessiveWith("ἐλεγον", Options{}) {
+		t.Errorf("Recessive() and RecessiveWith() differ")
+	}
+}
+
+func TestRebreathWith(t *testing.T) {
+	tests := []struct {
+		word     string
+		dialect  Dialect
+		expected string
+	}{
+		{"hυμεις", ATTIC, "ὑμεις"},
+		{"hυμμες", AEOLIC, "ὐμμες"},
+		{"hηλιος", IONIC, "ἠλιος"},
+		{"hηλιος", DORIC, "ἡλιος"},
+		{"ἁλιος", AEOLIC, "ἀλιος"},
+		{"ἐγω", IONIC, "ἐγω"},
+		{"αἱρεω", IONIC, "αἰρεω"},
+	}
+	for _, test := range tests {
+		if got := RebreathWith(test.word, Options{Dialect: test.dialect}); got != test.expected {
+			t.Errorf("RebreathWith(%q, %s) = %q, expected %q", test.word, test.dialect.Name(), got, test.expected)
+		}
+	}
+}
+
+func TestPsilosis(t *testing.T) {
+	tests := []struct {
+		word     string
+		expected string
+	}{
+		{"ἡμέρα", "ἠμέρα"},
+		{"οἱ", "οἰ"},
+		{"ῥήτωρ", "ῥήτωρ"},
+		{"Ἡμέρα", "Ἠμέρα"},
+		{"Ὅμηρος", "Ὄμηρος"},
+		{"Οἱ", "Οἰ"},
+		{"Ῥόδος", "Ῥόδος"},
+		// a breathing inside the word is kept
+		{"εὐἁγής", "εὐἁγής"},
+	}
+	for _, test := range tests {
+		if got := psilosis(test.word); got != test.expected {
+			t.Errorf("psilosis(%q) = %q, expected %q", test.word, got, test.expected)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package greekaccentuation
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ELISION_MARK is written in place of an elided vowel. It is the mark
+// used by most editions and by the TLG; the apostrophe, U+2019 and
+// U+02BC are also recognised as elision marks.
+const ELISION_MARK = '᾽'
+
+// IsElisionMark reports whether r is ELISION_MARK or one of the other
+// marks recognised in its place.
+func IsElisionMark(r rune) bool {
+	switch r {
+	case ELISION_MARK, '᾿', '’', 'ʼ', '\'':
+		return true
+	}
+	return false
+}
+
+// Elision is an elided word (ἀλλ᾽) or a word that has lost its first
+// vowel by prodelision ('στι).
+type Elision struct {
+	Form        string
+	Prodelision bool
+	// Full lists the possible full forms of the word for looking up its
+	// lemma. A form from the table of common elided words comes first;
+	// the rest are every vowel that may have been elided, and are not
+	// ordered by likelihood.
+	Full []string
+}
+
+// elidedForms maps the letters of common elided words, without their
+// diacritics and with an aspirated final consonant turned back, to their
+// full forms.
+var elidedForms = map[string]string{
+	"αλλ":   "ἀλλά",
+	"δ":     "δέ",
+	"τ":     "τε",
+	"γ":     "γε",
+	"μ":     "με",
+	"σ":     "σε",
+	"επ":    "ἐπί",
+	"απ":    "ἀπό",
+	"κατ":   "κατά",
+	"μετ":   "μετά",
+	"παρ":   "παρά",
+	"υπ":    "ὑπό",
+	"αντ":   "ἀντί",
+	"δι":    "διά",
+	"αν":    "ἀνά",
+	"αμφ":   "ἀμφί",
+	"ουδ":   "οὐδέ",
+	"μηδ":   "μηδέ",
+	"ουτ":   "οὔτε",
+	"μητ":   "μήτε",
+	"ποτ":   "ποτε",
+	"τουτ":  "τοῦτο",
+	"ταυτ":  "ταῦτα",
+	"πολλ":  "πολλά",
+	"εστ":   "ἐστί",
+	"ειτ":   "εἶτα",
+	"επειτ": "ἔπειτα",
+	"ιν":    "ἵνα",
+	"αμ":    "ἅμα",
+}
+
+// elisionLosesAccent lists the prepositions and conjunctions that lose
+// the accent of an elided final vowel rather than throwing it back.
+var elisionLosesAccent = map[string]bool{
+	"αλλα": true, "απο": true, "ανα": true, "αντι": true, "αμφι": true,
+	"δια": true, "επι": true, "κατα": true, "μετα": true, "παρα": true,
+	"υπο": true, "δε": true, "ουδε": true, "μηδε": true, "ηδε": true,
+	"ιδε": true,
+}
+
+var aspirates = map[rune]rune{'π': 'φ', 'τ': 'θ', 'κ': 'χ'}
+
+// aspirate turns the voiceless stops at the end of an elided word into
+// aspirates, as before a rough breathing: ἀπ᾽ → ἀφ᾽, νύκτ᾽ → νύχθ᾽.
+// With back true it turns them back.
+func aspirate(stem []rune, back bool) []rune {
+	result := append([]rune{}, stem...)
+	for i := len(result) - 1; i >= 0 && isConsonant(result[i]); i-- {
+		for stop, aspirated := range aspirates {
+			if back && result[i] == aspirated {
+				result[i] = stop
+			} else if !back && result[i] == stop {
+				result[i] = aspirated
+			}
+		}
+	}
+	return result
+}
+
+// hasRoughBreathing reports whether a word begins with a rough breathing.
+func hasRoughBreathing(word string) bool {
+	for _, ch := range norm.NFC.String(word) {
+		if breathing(ch) == ROUGH {
+			return true
+		}
+		if !IsVowel(unicode.ToLower(Base(ch))) && ch != 'ρ' && ch != 'ῥ' && ch != 'Ῥ' {
+			return false
+		}
+	}
+	return false
+}
+
+// ParseElision recognises an elided or prodelided word by its elision
+// mark and lists its possible full forms. The next word is used to tell
+// whether a final φ θ or χ was aspirated by a rough breathing (ἀφ᾽ οὗ is
+// ἀπό); if it is empty both readings are given.
+func ParseElision(word, next string) (Elision, bool) {
+	r := []rune(norm.NFC.String(word))
+	if len(r) < 2 {
+		return Elision{}, false
+	}
+	if IsElisionMark(r[0]) {
+		rest := string(r[1:])
+		full := string(AddBreathing('ε', SMOOTH)) + rest
+		return Elision{Form: word, Prodelision: true, Full: []string{full}}, true
+	}
+	if !IsElisionMark(r[len(r)-1]) {
+		return Elision{}, false
+	}
+
+	stem := r[:len(r)-1]
+	stems := [][]rune{stem}
+	if back := aspirate(stem, true); string(back) != string(stem) {
+		if hasRoughBreathing(next) {
+			stems = [][]rune{back}
+		} else if next == "" {
+			stems = append(stems, back)
+		}
+	}
+
+	e := Elision{Form: word}
+	seen := map[string]bool{}
+	add := func(full string) {
+		if !seen[full] {
+			seen[full] = true
+			e.Full = append(e.Full, full)
+		}
+	}
+	for _, s := range stems {
+		if full, ok := elidedForms[foldName(string(s))]; ok {
+			add(full)
+		}
+	}
+	for _, s := range stems {
+		syllables := Syllabify(string(s))
+		onLast := syllableAccent(syllables[len(syllables)-1]) != 0
+		plain := unaccented(string(s))
+		for _, v := range "αεοι" {
+			full := string(s) + string(v)
+			switch {
+			case GetAccentuation(string(s)) == NO_ACCENTUATION:
+				// The accent of an elided oxytone is lost
+				add(addAccentuation(Syllabify(plain+string(v)), OXYTONE))
+				add(full)
+			case onLast:
+				// or thrown back onto the penult
+				add(full)
+				add(addAccentuation(Syllabify(plain+string(v)), OXYTONE))
+			default:
+				add(full)
+			}
+		}
+	}
+	return e, true
+}
+
+// ExpandElision returns the possible full forms of an elided or
+// prodelided word, or the word itself if it is not elided.
+func ExpandElision(word, next string) []string {
+	e, ok := ParseElision(word, next)
+	if !ok {
+		return []string{word}
+	}
+	return e.Full
+}
+
+// Elide drops the final short vowel of a word before a word beginning
+// with a vowel. An elided oxytone loses its accent if it is a
+// preposition or conjunction (ἀλλά → ἀλλ᾽) and otherwise throws it back
+// onto the penult as an acute (πολλά → πόλλ᾽). Before a rough breathing
+// a final voiceless stop becomes aspirated (ἀπό οὗ → ἀφ᾽ οὗ). The word
+// is returned unchanged if it cannot be elided.
+func Elide(word, next string) string {
+	w := norm.NFC.String(word)
+	if next != "" {
+		first := []rune(norm.NFC.String(next))
+		if len(first) == 0 || !IsVowel(unicode.ToLower(Base(first[0]))) {
+			return w
+		}
+	}
+	r := []rune(w)
+	if len(r) < 2 || !strings.ContainsRune("αεοι", Base(r[len(r)-1])) {
+		return w
+	}
+
+	if GetAccentuation(w) == OXYTONE {
+		w = unaccented(w)
+		if !elisionLosesAccent[foldName(w)] && len(Syllabify(w)) > 1 {
+			w = addAccentuation(Syllabify(w), PAROXYTONE)
+		}
+		r = []rune(norm.NFC.String(w))
+	}
+	stem := r[:len(r)-1]
+	if hasRoughBreathing(next) {
+		stem = aspirate(stem, false)
+	}
+	return string(stem) + string(ELISION_MARK)
+}
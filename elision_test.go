@@ -0,0 +1,83 @@
+package greekaccentuation
+
+import (
+	"testing"
+)
+
+func TestElide(t *testing.T) {
+	tests := []struct {
+		word, next, expected string
+	}{
+		{"ἀλλά", "ἐγώ", "ἀλλ᾽"},
+		{"δέ", "ἐγώ", "δ᾽"},
+		{"ἐπί", "αὐτόν", "ἐπ᾽"},
+		{"ἀπό", "οὗ", "ἀφ᾽"},
+		{"κατά", "ἡμᾶς", "καθ᾽"},
+		{"νύκτα", "ὅλην", "νύχθ᾽"},
+		{"πολλά", "ἔπαθεν", "πόλλ᾽"},
+		{"δεινά", "ἐστι", "δείν᾽"},
+		{"ἄλλα", "ἔχει", "ἄλλ᾽"},
+		{"τοῦτο", "ἐστι", "τοῦτ᾽"},
+		{"δέ", "Ἀχιλλεύς", "δ᾽"},
+		{"ἀπό", "Ὅμηρος", "ἀφ᾽"},
+		{"κατά", "Οἱ", "καθ᾽"},
+		{"πολλά", "δέ", "πολλά"},
+		{"δέ", "Ζεύς", "δέ"},
+		{"λόγος", "ἐστι", "λόγος"},
+		{"", "ἐγώ", ""},
+		{"δ", "ἐγώ", "δ"},
+		{"", "", ""},
+	}
+	for _, test := range tests {
+		if got := Elide(test.word, test.next); got != test.expected {
+			t.Errorf("Elide(%q, %q) = %q, expected %q", test.word, test.next, got, test.expected)
+		}
+	}
+}
+
+func TestExpandElision(t *testing.T) {
+	tests := []struct {
+		word, next, expected string
+	}{
+		{"ἀλλ᾽", "", "ἀλλά"},
+		{"ἀλλ’", "", "ἀλλά"},
+		{"δ᾽", "", "δέ"},
+		{"ἀφ᾽", "οὗ", "ἀπό"},
+		{"ἀφ᾽", "", "ἀπό"},
+		{"καθ᾽", "ἡμᾶς", "κατά"},
+		{"πόλλ᾽", "", "πολλά"},
+		{"᾽στι", "", "ἐστι"},
+		{"'στι", "", "ἐστι"},
+	}
+	for _, test := range tests {
+		got := ExpandElision(test.word, test.next)
+		if len(got) == 0 || got[0] != test.expected {
+			t.Errorf("ExpandElision(%q, %q) = %q, expected %q first", test.word, test.next, got, test.expected)
+		}
+	}
+
+	if !stringArrayContains(ExpandElision("τέκν᾽", ""), "τέκνα") {
+		t.Errorf("ExpandElision() did not offer %q", "τέκνα")
+	}
+	if !stringArrayContains(ExpandElision("ἔγραφ᾽", "ἐν"), "ἔγραφε") {
+		t.Errorf("ExpandElision() failed. Returned %q", ExpandElision("ἔγραφ᾽", "ἐν"))
+	}
+	if stringArrayContains(ExpandElision("ἔγραφ᾽", "ἐν"), "ἔγραπε") {
+		t.Errorf("ExpandElision() turned back an aspirate before a smooth breathing")
+	}
+	if got := ExpandElision("λόγος", ""); len(got) != 1 || got[0] != "λόγος" {
+		t.Errorf("ExpandElision() changed a word that is not elided: %q", got)
+	}
+	if e, ok := ParseElision("᾽στι", ""); !ok || !e.Prodelision {
+		t.Errorf("ParseElision() failed. Returned %+v", e)
+	}
+}
+
+func stringArrayContains(a []string, s string) bool {
+	for _, x := range a {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
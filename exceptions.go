@@ -0,0 +1,93 @@
+package greekaccentuation
+
+import "sync"
+
+// ultimaEnding is an ending whose ultima counts as a fixed length for the
+// law of limitation.
+type ultimaEnding struct {
+	ending       string
+	length       Length
+	lemmaEndings []string
+}
+
+// ultimaExceptions holds the words and endings whose ultima the law of
+// limitation takes to be of a length other than its spelling shows.
+// Words are keyed by foldName.
+var ultimaExceptions = struct {
+	sync.RWMutex
+	words   map[string]Length
+	endings []ultimaEnding
+}{words: map[string]Length{}}
+
+// RegisterUltimaWord sets the length the law of limitation gives the
+// ultima of a word. A final -εως or -εων read with synizesis as one
+// syllable counts as SHORT, so the acute may stand on the antepenult
+// (ἵλεως, ἀνώγεων); a contracted ultima counts as LONG.
+func RegisterUltimaWord(word string, length Length) {
+	ultimaExceptions.Lock()
+	defer ultimaExceptions.Unlock()
+	ultimaExceptions.words[foldName(word)] = length
+}
+
+// RegisterUltimaEnding sets the length the law of limitation gives the
+// ultima of words with an ending, written without diacritics. If lemma
+// endings are given the rule is used only by Persistent, for a lemma with
+// one of them: πόλεως and πόλεων of πόλις keep the acute on the
+// antepenult, and ὀστᾶ of ὀστοῦν its circumflex. A SHORT ultima is only
+// given for a lemma of more than one syllable, so νεῶν of ναῦς is not
+// affected, and a LONG ultima only for a lemma with a circumflex on its
+// ultima, so Οἰδίποδα of Οἰδίπους is not. Endings registered first are
+// tried first.
+func RegisterUltimaEnding(ending string, length Length, lemmaEndings ...string) {
+	ultimaExceptions.Lock()
+	defer ultimaExceptions.Unlock()
+	ultimaExceptions.endings = append(ultimaExceptions.endings, ultimaEnding{ending, length, lemmaEndings})
+}
+
+// ultimaException returns the length the law of limitation gives the
+// ultima of a word of a lemma, which may be empty, if it is an exception.
+func ultimaException(word, lemma string) (Length, bool) {
+	ultimaExceptions.RLock()
+	defer ultimaExceptions.RUnlock()
+	if length, ok := ultimaExceptions.words[foldName(word)]; ok {
+		return length, true
+	}
+	for _, e := range ultimaExceptions.endings {
+		if !hasEnding(word, e.ending) {
+			continue
+		}
+		if len(e.lemmaEndings) > 0 && (lemma == "" || !hasEnding(lemma, e.lemmaEndings...)) {
+			continue
+		}
+		if e.length == SHORT && len(e.lemmaEndings) > 0 && len(Syllabify(lemma)) < 2 {
+			continue
+		}
+		if e.length == LONG && len(e.lemmaEndings) > 0 && getAccentuation(lemma) != PERISPOMENON {
+			continue
+		}
+		return e.length, true
+	}
+	return UNKNOWN, false
+}
+
+func init() {
+	// The genitive in -εως and -εων of the third declension nouns in -ις,
+	// -υς and -υ (πόλεως, πήχεως, ἄστεως), and the Attic declension
+	// (Μενέλεως, Μενέλεω, ἀνώγεων)
+	for _, ending := range []string{"εως", "εων"} {
+		RegisterUltimaEnding(ending, SHORT, "ις", "υς", "υ", "εως", "εων")
+	}
+	RegisterUltimaEnding("εω", SHORT, "εως", "εων")
+	for _, word := range []string{
+		"ἵλεως", "ἵλεων", "ἵλεω", "ἔκπλεως", "ἔκπλεων", "ἀνώγεων", "ἀνώγεω",
+		"Μενέλεως", "Μενέλεων", "Μενέλεω",
+	} {
+		RegisterUltimaWord(word, SHORT)
+	}
+
+	// The contracted nouns and adjectives of the second declension keep
+	// the circumflex of the contraction (ὀστοῦν, ὀστᾶ; χρυσοῦς, χρυσᾶ;
+	// νοῦς, νοῖ)
+	RegisterUltimaEnding("α", LONG, "ους", "ουν")
+	RegisterUltimaEnding("οι", LONG, "ους", "ουν")
+}
@@ -0,0 +1,81 @@
+package greekaccentuation
+
+import "testing"
+
+func TestUltimaExceptions(t *testing.T) {
+	if got := Persistent("πολεως", "πόλις", false); got != "πόλεως" {
+		t.Errorf("Persistent(%q, %q) = %q, expected %q", "πολεως", "πόλις", got, "πόλεως")
+	}
+
+	tests := []struct {
+		word, lemma string
+		options     Options
+		expected    string
+	}{
+		{"πολεως", "πόλις", Options{}, "πόλεως"},
+		{"πολεων", "πόλις", Options{}, "πόλεων"},
+		{"πολει", "πόλις", Options{}, "πόλει"},
+		{"πηχεως", "πῆχυς", Options{}, "πήχεως"},
+		{"ἀστεως", "ἄστυ", Options{}, "ἄστεως"},
+		{"Μενελεω", "Μενέλεως", Options{}, "Μενέλεω"},
+		{"Μενελεων", "Μενέλεως", Options{}, "Μενέλεων"},
+		{"νεω", "νεώς", Options{}, "νεώ"},
+		{"λεων", "λεώς", Options{}, "λεών"},
+		{"ὀστα", "ὀστοῦν", Options{}, "ὀστᾶ"},
+		{"ὀστων", "ὀστοῦν", Options{}, "ὀστῶν"},
+		{"χρυσα", "χρυσοῦς", Options{}, "χρυσᾶ"},
+		{"νοι", "νοῦς", Options{}, "νοῖ"},
+		{"νου", "νοῦς", Options{}, "νοῦ"},
+		// not exceptions
+		{"βασιλεως", "βασιλεύς", Options{}, "βασιλέως"},
+		{"νεων", "ναῦς", Options{Inflection: GENITIVE_PLURAL}, "νεῶν"},
+		{"λογοι", "λόγος", Options{}, "λόγοι"},
+		{"δωρα", "δῶρον", Options{}, "δῶρα"},
+		{"Οἰδιποδα", "Οἰδίπους", Options{}, "Οἰδίποδα"},
+		{"ποδα", "πούς", Options{}, "πόδα"},
+	}
+	for _, test := range tests {
+		if got := PersistentWith(test.word, test.lemma, test.options); got != test.expected {
+			t.Errorf("PersistentWith(%q, %q) = %q, expected %q", test.word, test.lemma, got, test.expected)
+		}
+	}
+
+	if !accentuationInSet(PROPAROXYTONE, PossibleAccentuations("ἵλεως", Options{})) {
+		t.Errorf("PossibleAccentuations(%q) = %v", "ἵλεως", PossibleAccentuations("ἵλεως", Options{}))
+	}
+	if accentuationInSet(PROPAROXYTONE, PossibleAccentuations("βασιλεως", Options{})) {
+		t.Errorf("PossibleAccentuations(%q) = %v", "βασιλεως", PossibleAccentuations("βασιλεως", Options{}))
+	}
+	if got := RecessiveWith("ἱλεως", Options{}); got != "ἵλεως" {
+		t.Errorf("RecessiveWith(%q) = %q", "ἱλεως", got)
+	}
+}
+
+// restoreUltimaExceptions puts back the registered exceptions as they
+// were when the test began.
+func restoreUltimaExceptions(t *testing.T) {
+	ultimaExceptions.RLock()
+	words := map[string]Length{}
+	for w, length := range ultimaExceptions.words {
+		words[w] = length
+	}
+	endings := append([]ultimaEnding{}, ultimaExceptions.endings...)
+	ultimaExceptions.RUnlock()
+	t.Cleanup(func() {
+		ultimaExceptions.Lock()
+		ultimaExceptions.words = words
+		ultimaExceptions.endings = endings
+		ultimaExceptions.Unlock()
+	})
+}
+
+func TestRegisterUltimaWord(t *testing.T) {
+	restoreUltimaExceptions(t)
+	if got := RecessiveWith("εὐγεως", Options{}); got != "εὐγέως" {
+		t.Fatalf("RecessiveWith() = %q", got)
+	}
+	RegisterUltimaWord("εὔγεως", SHORT)
+	if got := RecessiveWith("εὐγεως", Options{}); got != "εὔγεως" {
+		t.Errorf("RecessiveWith() after RegisterUltimaWord = %q", got)
+	}
+}
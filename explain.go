@@ -0,0 +1,134 @@
+package greekaccentuation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PersistentStep is one accentuation Persistent tried for a word.
+type PersistentStep struct {
+	Rule         string // why this accentuation was tried
+	Position     int    // syllable counted from the end, 1 is the ultima
+	Accent       Accent
+	Accentuation Accentuation // NO_ACCENTUATION if none exists for the position and accent
+	Accepted     bool
+	Reason       string // why the accentuation was rejected
+}
+
+// PersistentTrace records how Persistent chose the accent of a word.
+type PersistentTrace struct {
+	Word              string
+	Lemma             string
+	LemmaAccentuation Accentuation
+	LemmaSyllables    []string
+	WordSyllables     []string
+	// UltimaLength and PenultLength are the lengths that decided the
+	// allowed accentuations.
+	UltimaLength Length
+	PenultLength Length
+	Allowed      []Accentuation
+	// Position is the syllable of the word, counted from the end, that
+	// corresponds to the accented syllable of the lemma.
+	Position int
+	Steps    []PersistentStep
+	Result   Accentuation
+	Form     string
+	Notes    []string
+}
+
+// ExplainPersistent returns the same form as Persistent together with a
+// trace of how the accent was chosen.
+func ExplainPersistent(word string, lemma string, defaultShort bool) (string, PersistentTrace) {
+	trace := PersistentTrace{Word: word, Lemma: lemma}
+	trace.Form, _ = persistent(word, lemma, persistentOptions(defaultShort), &trace)
+	return trace.Form, trace
+}
+
+func (t *PersistentTrace) note(s string) {
+	if t != nil {
+		t.Notes = append(t.Notes, s)
+	}
+}
+
+// try records an attempt to use an accentuation and returns whether it
+// is one of the possible accentuations.
+func (t *PersistentTrace) try(rule string, position int, accent Accent, a Accentuation, possible []Accentuation) bool {
+	accepted := accentuationInSet(a, possible)
+	if t == nil {
+		return accepted
+	}
+	step := PersistentStep{
+		Rule:         rule,
+		Position:     position,
+		Accent:       accent,
+		Accentuation: a,
+		Accepted:     accepted,
+	}
+	switch {
+	case accepted:
+	case a == NO_ACCENTUATION && (position < 1 || position > len(t.WordSyllables)):
+		step.Reason = fmt.Sprintf("the word has no syllable %d from the end", position)
+	case a == NO_ACCENTUATION:
+		step.Reason = fmt.Sprintf("no %s can stand on syllable %d", strings.ToLower(accent.Name()), position)
+	default:
+		step.Reason = t.limitation(a)
+	}
+	t.Steps = append(t.Steps, step)
+	return accepted
+}
+
+// limitation explains why an accentuation is not allowed.
+func (t *PersistentTrace) limitation(a Accentuation) string {
+	switch a {
+	case PERISPOMENON:
+		return "a circumflex needs a long ultima"
+	case PAROXYTONE:
+		return "a long penult takes a circumflex before a short ultima"
+	case PROPERISPOMENON:
+		if t.PenultLength == SHORT {
+			return "a circumflex needs a long penult"
+		}
+		return "a long ultima does not allow a circumflex on the penult"
+	case PROPAROXYTONE:
+		if len(t.WordSyllables) < 3 {
+			return fmt.Sprintf("the word has only %d syllables", len(t.WordSyllables))
+		}
+		return "a long ultima does not allow an accent on the antepenult"
+	}
+	return "not allowed"
+}
+
+func accentuationNames(a []Accentuation) string {
+	var names []string
+	for _, x := range a {
+		names = append(names, DisplayAccentuation(x))
+	}
+	return strings.Join(names, ", ")
+}
+
+// String formats the trace for reading.
+func (t PersistentTrace) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "word: %s (%s)\n", t.Word, DisplayWord(t.WordSyllables))
+	fmt.Fprintf(&b, "lemma: %s (%s), %s\n", t.Lemma, DisplayWord(t.LemmaSyllables),
+		DisplayAccentuation(t.LemmaAccentuation))
+	if t.WordSyllables != nil {
+		fmt.Fprintf(&b, "ultima: %s, penult: %s\n",
+			strings.ToLower(t.UltimaLength.Name()), strings.ToLower(t.PenultLength.Name()))
+		fmt.Fprintf(&b, "allowed: %s\n", accentuationNames(t.Allowed))
+	}
+	for _, s := range t.Steps {
+		if s.Accepted {
+			fmt.Fprintf(&b, "%s: %s accepted\n", s.Rule, DisplayAccentuation(s.Accentuation))
+		} else if s.Accentuation == NO_ACCENTUATION {
+			fmt.Fprintf(&b, "%s: rejected, %s\n", s.Rule, s.Reason)
+		} else {
+			fmt.Fprintf(&b, "%s: %s rejected, %s\n", s.Rule, DisplayAccentuation(s.Accentuation), s.Reason)
+		}
+	}
+	for _, n := range t.Notes {
+		fmt.Fprintf(&b, "%s\n", n)
+	}
+	fmt.Fprintf(&b, "result: %s", t.Form)
+	return b.String()
+}
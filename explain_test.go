@@ -0,0 +1,64 @@
+package greekaccentuation
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExplainPersistent(t *testing.T) {
+	pairs := [][2]string{
+		{"ἀνθρωπος", "ἄνθρωπος"},
+		{"ἀνθρωπου", "ἄνθρωπος"},
+		{"καταβαινον", "καταβαίνων"},
+		{"Ααρων", "Ααρων"},
+		{"περιπατει", "περιπατῶ"},
+	}
+	for _, p := range pairs {
+		form, _ := ExplainPersistent(p[0], p[1], false)
+		if form != Persistent(p[0], p[1], false) {
+			t.Fatalf("ExplainPersistent() failed. Returned %s, expected %s", form, Persistent(p[0], p[1], false))
+		}
+	}
+
+	form, trace := ExplainPersistent("ἀνθρωπου", "ἄνθρωπος", false)
+	if form != "ἀνθρώπου" || trace.Result != PAROXYTONE {
+		t.Fatalf("ExplainPersistent() failed. Returned %s", form)
+	}
+	if trace.LemmaAccentuation != PROPAROXYTONE || trace.UltimaLength != LONG || trace.Position != 3 {
+		t.Fatalf("ExplainPersistent() failed. Returned %+v", trace)
+	}
+	if len(trace.Steps) != 3 {
+		t.Fatalf("ExplainPersistent() failed. Returned steps %+v", trace.Steps)
+	}
+	if trace.Steps[0].Accepted || trace.Steps[0].Reason != "a long ultima does not allow an accent on the antepenult" {
+		t.Fatalf("ExplainPersistent() failed. Returned step %+v", trace.Steps[0])
+	}
+	if trace.Steps[1].Accepted || trace.Steps[1].Accent != CIRCUMFLEX {
+		t.Fatalf("ExplainPersistent() failed. Returned step %+v", trace.Steps[1])
+	}
+	if !trace.Steps[2].Accepted || trace.Steps[2].Accentuation != PAROXYTONE {
+		t.Fatalf("ExplainPersistent() failed. Returned step %+v", trace.Steps[2])
+	}
+
+	// An acute becomes a circumflex on a long penult before a short ultima
+	_, trace = ExplainPersistent("καταβαινον", "καταβαίνων", false)
+	if len(trace.Steps) != 2 || trace.Steps[1].Rule != "circumflex in place of acute" {
+		t.Fatalf("ExplainPersistent() failed. Returned steps %+v", trace.Steps)
+	}
+	if !strings.Contains(trace.String(), "paroxytone rejected, a long penult takes a circumflex before a short ultima") {
+		t.Fatalf("ExplainPersistent() failed. Returned %s", trace.String())
+	}
+}
+
+func TestExplainPersistentFailures(t *testing.T) {
+	form, trace := ExplainPersistent("Ααρων", "Ααρων", false)
+	if form != "" || len(trace.Notes) != 1 || trace.Notes[0] != "the lemma has no accent" {
+		t.Fatalf("ExplainPersistent() failed. Returned %+v", trace)
+	}
+
+	// Persistent panics on this pair
+	form, trace = ExplainPersistent("λος", "καταλαμβάνω", false)
+	if form != "" || trace.Result != NO_ACCENTUATION || len(trace.Steps) != 6 {
+		t.Fatalf("ExplainPersistent() failed. Returned %+v", trace)
+	}
+}
@@ -0,0 +1,91 @@
+//go:build ignore
+// +build ignore
+
+// gen_tables generates tables.go, the precomputed decomposition and
+// composition tables for the Greek and Greek Extended unicode blocks.
+//
+// Run with: go generate
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+type block struct {
+	name  string
+	first rune
+	last  rune
+}
+
+var blocks = []block{
+	{"greek", 0x0370, 0x03FF},
+	{"greekExtended", 0x1F00, 0x1FFF},
+}
+
+func main() {
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by gen_tables.go; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package greekaccentuation")
+	fmt.Fprintln(&b)
+
+	compositions := map[string]rune{}
+	for _, bl := range blocks {
+		fmt.Fprintf(&b, "const %sFirst = 0x%04X\n", bl.name, bl.first)
+		fmt.Fprintf(&b, "const %sLast = 0x%04X\n\n", bl.name, bl.last)
+		fmt.Fprintf(&b, "var %sDecompositions = [...]decomposition{\n", bl.name)
+		for ch := bl.first; ch <= bl.last; ch++ {
+			d := []rune(norm.NFD.String(string(ch)))
+			fmt.Fprintf(&b, "\t{0x%04X, %s}, // %U\n", d[0], runeSlice(d[1:]), ch)
+
+			c := []rune(norm.NFC.String(string(d)))
+			if len(c) == 1 {
+				compositions[string(d)] = c[0]
+			}
+		}
+		fmt.Fprintln(&b, "}")
+		fmt.Fprintln(&b)
+	}
+
+	keys := make([]string, 0, len(compositions))
+	for k := range compositions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fmt.Fprintln(&b, "var greekCompositions = map[string]rune{")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "\t%+q: 0x%04X,\n", k, compositions[k])
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("tables.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func runeSlice(r []rune) string {
+	if len(r) == 0 {
+		return "nil"
+	}
+	var b bytes.Buffer
+	b.WriteString("[]rune{")
+	for i, ch := range r {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "0x%04X", ch)
+	}
+	b.WriteString("}")
+	return b.String()
+}
@@ -2,4 +2,4 @@ module github.com/biblical-text/greekaccentuation
 
 go 1.16
 
-require golang.org/x/text v0.3.7 // indirect
+require golang.org/x/text v0.3.7
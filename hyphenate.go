@@ -0,0 +1,334 @@
+package greekaccentuation
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// HyphenationMode selects the conventions a Hyphenator follows.
+type HyphenationMode int
+
+const (
+	// ANCIENT_GREEK divides polytonic text by Smyth's rule, and a compound
+	// at the boundary of its prefix (συν-ά-γω, ἐκ-λέ-γω) where the word
+	// marks it or the Hyphenator lists it.
+	ANCIENT_GREEK HyphenationMode = 0
+	// MODERN_GREEK divides monotonic text by the Modern Greek school rule
+	// and does not break a vowel from an unaccented ι before it, which is
+	// pronounced with it (καρ-διά).
+	MODERN_GREEK HyphenationMode = 1
+)
+
+func (e HyphenationMode) Name() string {
+	switch e {
+	case ANCIENT_GREEK:
+		return "ANCIENT_GREEK"
+	case MODERN_GREEK:
+		return "MODERN_GREEK"
+	}
+	return ""
+}
+
+// Hyphenator finds the places a word may be broken at the end of a line.
+type Hyphenator struct {
+	Mode   HyphenationMode
+	Policy SyllabificationPolicy
+	// LeftMin and RightMin are the fewest letters left before and after
+	// a break.
+	LeftMin  int
+	RightMin int
+	// Compounds is a lexicon of the beginnings of compounds with a
+	// COMPOUND_BOUNDARY after the prefix (συν|άγ, ἐκ|λέγ, καθ|ίστ). A
+	// word that begins with one, ignoring diacritics and case, is
+	// divided at the boundary. An entry without a boundary is the
+	// beginning of words that are not compounds (ἀνήρ), and the longest
+	// entry a word begins with is used.
+	Compounds []string
+}
+
+// COMMON_COMPOUNDS is the lexicon of compounds of the ANCIENT_GREEK
+// hyphenator: the prepositional and other prefixes that are kept whole,
+// each given before the letters it is found before in compounds (ἐκ
+// before a consonant, ἐξ before a vowel, so ἐκεῖνος is not divided
+// ἐκ-εῖ-νος), with the common words that begin like them.
+var COMMON_COMPOUNDS = commonCompounds()
+
+func commonCompounds() []string {
+	const vowels, consonants = "αεηιουω", "βγδζθκλμνξπρστφχψ"
+	var result []string
+	add := func(prefix, before string) {
+		for _, ch := range before {
+			result = append(result, prefix+string(COMPOUND_BOUNDARY)+string(ch))
+		}
+	}
+	add("συν", vowels+consonants)
+	add("ἐκ", consonants)
+	add("ἐξ", vowels)
+	add("εἰσ", vowels+consonants)
+	add("προσ", vowels+consonants)
+	add("δυσ", vowels+consonants)
+	// ἀνα, ἀπο and ὑπο keep their vowel before a consonant
+	add("ἀν", "εηιουω")
+	add("ἀπ", "αεηιυω")
+	add("ὑπ", "αεηιυω")
+	// words that begin as a compound does
+	return append(result,
+		"ἀνήρ", "ἀνέρ", "ἄνεμ", "ἀνία", "ἀνύ", "ἄνω", "εἰσίν",
+		"ἁπαλ", "ἅπα", "ἀπάτ", "ἄπειρ", "ὕπατ", "ὑπέρ",
+	)
+}
+
+// NewHyphenator returns a hyphenator for a mode that leaves at least two
+// letters either side of a break. The ANCIENT_GREEK hyphenator keeps
+// the prefixes of COMMON_COMPOUNDS whole.
+func NewHyphenator(mode HyphenationMode) *Hyphenator {
+	if mode == MODERN_GREEK {
+		return &Hyphenator{Mode: mode, Policy: MODERN, LeftMin: 2, RightMin: 2}
+	}
+	return &Hyphenator{Mode: mode, Policy: SMYTH, LeftMin: 2, RightMin: 2,
+		Compounds: append([]string{}, COMMON_COMPOUNDS...)}
+}
+
+// letterOffset returns the byte offset in word after its first n letters
+// and their combining marks.
+func letterOffset(word string, n int) int {
+	letters := 0
+	for i, ch := range word {
+		if !isCombiningMark(ch) {
+			if letters == n {
+				return i
+			}
+			letters++
+		}
+	}
+	return len(word)
+}
+
+// compoundBoundary returns the byte offset in word of the boundary of the
+// longest entry of the lexicon of compounds it begins with, or 0 if that
+// entry has no boundary or it begins with none.
+func (h *Hyphenator) compoundBoundary(word string) int {
+	folded := Fold(word, FOLD_ALL)
+	boundary, longest := 0, 0
+	for _, c := range h.Compounds {
+		parts := strings.SplitN(c, string(COMPOUND_BOUNDARY), 2)
+		key := Fold(strings.Join(parts, ""), FOLD_ALL)
+		if len(key) <= longest || !strings.HasPrefix(folded, key) {
+			continue
+		}
+		longest = len(key)
+		boundary = 0
+		if len(parts) == 2 {
+			boundary = letterOffset(word, len([]rune(Fold(parts[0], FOLD_ALL))))
+		}
+	}
+	return boundary
+}
+
+// Hyphenate returns the byte offsets at which a word may be broken, in
+// increasing order. A COMPOUND_BOUNDARY in the word divides it there in
+// place of the lexicon of compounds; the offsets are then into the word
+// with its boundary marks removed (κατ|άγω gives the offsets of
+// κατ-ά-γω in κατάγω).
+func (h *Hyphenator) Hyphenate(word string) []int {
+	marked := word
+	if !strings.ContainsRune(word, COMPOUND_BOUNDARY) {
+		if b := h.compoundBoundary(word); b > 0 {
+			marked = word[:b] + string(COMPOUND_BOUNDARY) + word[b:]
+		}
+	}
+	spans := SyllabifySpansWith(marked, h.Policy)
+	// unmarked returns the offset in the word without boundary marks of
+	// an offset in marked
+	unmarked := func(i int) int {
+		return i - strings.Count(marked[:i], string(COMPOUND_BOUNDARY))
+	}
+
+	letters := len(letterRunes(word))
+	var breaks []int
+	before := 0
+	for i, s := range spans {
+		before += len(letterRunes(s.Text))
+		if i == len(spans)-1 {
+			break
+		}
+		if before < h.LeftMin || letters-before < h.RightMin {
+			continue
+		}
+		if h.Mode == MODERN_GREEK && synizesis(s.Text, spans[i+1].Text) {
+			continue
+		}
+		breaks = append(breaks, unmarked(spans[i+1].Start))
+	}
+	return breaks
+}
+
+// letterRunes returns the letters of text without their combining marks.
+func letterRunes(text string) []rune {
+	var r []rune
+	for _, ch := range norm.NFD.String(text) {
+		if !isCombiningMark(ch) && ch != COMPOUND_BOUNDARY {
+			r = append(r, ch)
+		}
+	}
+	return r
+}
+
+// synizesis reports whether a syllable ends in an unaccented ι that is
+// said with the vowel beginning the next syllable (δι-ά in καρδιά).
+func synizesis(syllable, next string) bool {
+	s := clusters(syllable)
+	n := letterRunes(next)
+	if len(s) == 0 || len(n) == 0 || !IsVowel(n[0]) {
+		return false
+	}
+	last := s[len(s)-1]
+	return unicode.ToLower(last[0]) == 'ι' && !hasMark(last, accent) && !hasMark(last, diaeresis)
+}
+
+// HyphenateText inserts hyphen at each place a word of text may be broken.
+// A soft hyphen, "\u00ad", lets a renderer break lines there.
+func (h *Hyphenator) HyphenateText(text, hyphen string) string {
+	var b strings.Builder
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		word := text[start:end]
+		last := 0
+		for _, i := range h.Hyphenate(word) {
+			b.WriteString(word[last:i])
+			b.WriteString(hyphen)
+			last = i
+		}
+		b.WriteString(word[last:])
+		start = -1
+	}
+	for i, ch := range text {
+		if unicode.IsLetter(ch) || (start >= 0 && isCombiningMark(ch)) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		flush(i)
+		b.WriteRune(ch)
+	}
+	flush(len(text))
+	return b.String()
+}
+
+// maxPatternContext is the most letters of context either side of a
+// break that Patterns tries before it falls back to the whole word.
+const maxPatternContext = 4
+
+// Patterns returns TeX hyphenation patterns learnt from a list of words
+// hyphenated as the hyphenator does. Each break is given by the shortest
+// context of letters around it (α1λα, .ἐκ1λ) that is followed by a break
+// wherever it occurs in the list, so the patterns break the words of the
+// list as Hyphenate does and carry the same breaks over to words that
+// are not in it. A break with no such context within maxPatternContext
+// letters either side is given by the whole word. Words are taken in
+// lower case NFC, with any COMPOUND_BOUNDARY marking a compound.
+func (h *Hyphenator) Patterns(words []string) []string {
+	type entry struct {
+		letters []rune // the word between dots
+		breaks  map[int]bool
+	}
+	var entries []entry
+	seen := map[string]bool{}
+	for _, w := range words {
+		w = ToLower(norm.NFC.String(w))
+		plain := strings.ReplaceAll(w, string(COMPOUND_BOUNDARY), "")
+		if plain == "" || seen[plain] {
+			continue
+		}
+		seen[plain] = true
+		e := entry{[]rune("." + plain + "."), map[int]bool{}}
+		for _, b := range h.Hyphenate(w) {
+			// the break is before rune index n of the dotted word
+			e.breaks[utf8.RuneCountInString(plain[:b])+1] = true
+		}
+		entries = append(entries, e)
+	}
+
+	// context returns the l letters before and r letters after position
+	// i of a dotted word, or false if there are not enough
+	context := func(letters []rune, i, l, r int) (string, bool) {
+		if i-l < 0 || i+r > len(letters) {
+			return "", false
+		}
+		return string(letters[i-l:i]) + "1" + string(letters[i:i+r]), true
+	}
+	// consistent records for each context whether every occurrence of it
+	// in the list is a break
+	consistent := map[string]bool{}
+	for _, e := range entries {
+		for i := 1; i < len(e.letters); i++ {
+			for l := 1; l <= maxPatternContext; l++ {
+				for r := 1; r <= maxPatternContext; r++ {
+					if c, ok := context(e.letters, i, l, r); ok {
+						prior, found := consistent[c]
+						consistent[c] = e.breaks[i] && (prior || !found)
+					}
+				}
+			}
+		}
+	}
+
+	unique := map[string]bool{}
+	for _, e := range entries {
+	breaks:
+		for i := range e.breaks {
+			for size := 2; size <= 2*maxPatternContext; size++ {
+				for l := 1; l < size; l++ {
+					if c, ok := context(e.letters, i, l, size-l); ok && consistent[c] {
+						unique[c] = true
+						continue breaks
+					}
+				}
+			}
+			whole, _ := context(e.letters, i, i, len(e.letters)-i)
+			unique[whole] = true
+		}
+	}
+	var patterns []string
+	for p := range unique {
+		patterns = append(patterns, p)
+	}
+	sort.Strings(patterns)
+	return patterns
+}
+
+// WriteTeXPatterns writes the patterns for a list of words as a TeX
+// \patterns command, one pattern a line.
+func (h *Hyphenator) WriteTeXPatterns(w io.Writer, words []string) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%% %s hyphenation patterns\n", h.Mode.Name())
+	fmt.Fprintln(bw, `\patterns{`)
+	for _, p := range h.Patterns(words) {
+		fmt.Fprintln(bw, p)
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// Hyphenate returns the byte offsets at which a word of polytonic Ancient
+// Greek may be broken.
+func Hyphenate(word string) []int {
+	return NewHyphenator(ANCIENT_GREEK).Hyphenate(word)
+}
+
+// HyphenateText inserts hyphen at each place a word of polytonic Ancient
+// Greek text may be broken.
+func HyphenateText(text, hyphen string) string {
+	return NewHyphenator(ANCIENT_GREEK).HyphenateText(text, hyphen)
+}
@@ -0,0 +1,170 @@
+package greekaccentuation
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func hyphenated(h *Hyphenator, word string) string {
+	breaks := h.Hyphenate(word)
+	word = strings.ReplaceAll(word, string(COMPOUND_BOUNDARY), "")
+	var parts []string
+	last := 0
+	for _, i := range breaks {
+		parts = append(parts, word[last:i])
+		last = i
+	}
+	return strings.Join(append(parts, word[last:]), "-")
+}
+
+func TestHyphenate(t *testing.T) {
+	ancient := NewHyphenator(ANCIENT_GREEK)
+	compounds := NewHyphenator(ANCIENT_GREEK)
+	compounds.Compounds = append(compounds.Compounds, "καθ|ίστ", "ἀπο|θνῄσκ")
+	none := NewHyphenator(ANCIENT_GREEK)
+	none.Compounds = nil
+	modern := NewHyphenator(MODERN_GREEK)
+	tests := []struct {
+		h        *Hyphenator
+		word     string
+		expected string
+	}{
+		{ancient, "ἄνθρωπος", "ἄν-θρω-πος"},
+		{ancient, "θάλασσα", "θά-λασ-σα"},
+		{ancient, "πολύτροπον", "πο-λύ-τρο-πον"},
+		// no single letter at either end
+		{ancient, "ἀγαθός", "ἀγα-θός"},
+		{ancient, "θεά", "θεά"},
+		{ancient, "ὁδοί", "ὁδοί"},
+		// not compounds, though they begin as ἐκ- and καθ- do
+		{ancient, "ἐκεῖνος", "ἐκεῖ-νος"},
+		{ancient, "ἐκεῖ", "ἐκεῖ"},
+		{ancient, "καθαρός", "κα-θα-ρός"},
+		{ancient, "ἀνάγκη", "ἀνάγ-κη"},
+		{ancient, "ἀνήρ", "ἀνήρ"},
+		{compounds, "ἐκεῖνος", "ἐκεῖ-νος"},
+		{compounds, "καθαρός", "κα-θα-ρός"},
+		// the common prefixes are kept whole
+		{ancient, "συνάγω", "συν-ά-γω"},
+		{ancient, "συνάγομεν", "συν-ά-γο-μεν"},
+		{ancient, "ἐκλέγω", "ἐκ-λέ-γω"},
+		{ancient, "ἐξάγω", "ἐξ-ά-γω"},
+		{ancient, "εἰσάγω", "εἰσ-ά-γω"},
+		{ancient, "δυστυχής", "δυσ-τυ-χής"},
+		{ancient, "προσφέρω", "προσ-φέ-ρω"},
+		{ancient, "ἀνέχω", "ἀν-έ-χω"},
+		{ancient, "ἀπέχω", "ἀπ-έ-χω"},
+		{ancient, "ἀπό", "ἀπό"},
+		{ancient, "ἀπολύω", "ἀπο-λύω"},
+		{ancient, "ἀναβαίνω", "ἀνα-βαί-νω"},
+		{ancient, "ὑπέρ", "ὑπέρ"},
+		{ancient, norm.NFD.String("συνάγω"), norm.NFD.String("συν-ά-γω")},
+		{none, "συνάγω", "συ-νά-γω"},
+		// and others from the lexicon of compounds
+		{ancient, "καθίστημι", "κα-θί-στη-μι"},
+		{compounds, "καθίστημι", "καθ-ί-στη-μι"},
+		{compounds, "ἀποθνῄσκω", "ἀπο-θνῄ-σκω"},
+		// and from a boundary marked in the word
+		{ancient, "κατ|άγω", "κατ-ά-γω"},
+		{ancient, "κατάγω", "κα-τά-γω"},
+		{ancient, "ἀπόλλυμι", "ἀπόλ-λυ-μι"},
+		// modern
+		{modern, "άμπωτη", "άμπω-τη"},
+		{modern, "ατμόσφαιρα", "ατμό-σφαι-ρα"},
+		{modern, "καρδιά", "καρ-διά"},
+		{modern, "παιδιά", "παι-διά"},
+		{modern, "θάλασσα", "θά-λασ-σα"},
+		{modern, "συνάγω", "συ-νά-γω"},
+	}
+	for _, test := range tests {
+		if got := hyphenated(test.h, test.word); got != test.expected {
+			t.Errorf("%s Hyphenate(%q) = %q, expected %q", test.h.Mode.Name(), test.word, got, test.expected)
+		}
+	}
+
+	// offsets are into the word without its boundary marks
+	if got := Hyphenate("κατ|άγω"); len(got) != 2 || got[0] != len("κατ") || got[1] != len("κατά") {
+		t.Errorf("Hyphenate(%q) = %v", "κατ|άγω", got)
+	}
+	if got := Hyphenate("συν|άγω"); len(got) != 2 || got[0] != len("συν") || got[1] != len("συνά") {
+		t.Errorf("Hyphenate(%q) = %v", "συν|άγω", got)
+	}
+}
+
+func TestHyphenateText(t *testing.T) {
+	got := HyphenateText("ἄνδρα μοι ἔννεπε, μοῦσα, πολύτροπον", "\u00ad")
+	expected := "ἄν\u00adδρα μοι ἔν\u00adνε\u00adπε, μοῦ\u00adσα, πο\u00adλύ\u00adτρο\u00adπον"
+	if got != expected {
+		t.Errorf("HyphenateText() = %q, expected %q", got, expected)
+	}
+	if got := NewHyphenator(MODERN_GREEK).HyphenateText("Η καρδιά μου.", "-"); got != "Η καρ-διά μου." {
+		t.Errorf("HyphenateText() = %q", got)
+	}
+}
+
+// patternBreaks hyphenates a word by TeX patterns: the greatest digit
+// given to a place between two letters by any pattern matching there
+// allows a break if it is odd.
+func patternBreaks(patterns []string, word string) []int {
+	letters := []rune("." + word + ".")
+	values := make([]int, len(letters)+1)
+	for _, p := range patterns {
+		var text []rune
+		digits := map[int]int{}
+		for _, ch := range p {
+			if ch >= '0' && ch <= '9' {
+				digits[len(text)] = int(ch - '0')
+			} else {
+				text = append(text, ch)
+			}
+		}
+		for start := 0; start+len(text) <= len(letters); start++ {
+			if string(letters[start:start+len(text)]) != string(text) {
+				continue
+			}
+			for at, d := range digits {
+				if d > values[start+at] {
+					values[start+at] = d
+				}
+			}
+		}
+	}
+	var breaks []int
+	offset := 0
+	for i, ch := range []rune(word) {
+		if i > 0 && values[i+1]%2 == 1 {
+			breaks = append(breaks, offset)
+		}
+		offset += len(string(ch))
+	}
+	return breaks
+}
+
+func TestWriteTeXPatterns(t *testing.T) {
+	h := NewHyphenator(ANCIENT_GREEK)
+	words := []string{"συν|άγω", "Ἄνθρωπος", "ἄνθρωπος", "θεός", "λόγος", "ἄγγελος", "σοφία"}
+	patterns := h.Patterns(words)
+	for _, w := range words {
+		plain := ToLower(strings.ReplaceAll(w, string(COMPOUND_BOUNDARY), ""))
+		if got, expected := patternBreaks(patterns, plain), h.Hyphenate(ToLower(w)); !intArrayEqual(got, expected) {
+			t.Errorf("patterns %v break %q at %v, expected %v", patterns, plain, got, expected)
+		}
+	}
+	// the patterns carry over to words not in the list
+	for _, w := range []string{"ἄγγελον", "σοφός"} {
+		if got, expected := patternBreaks(patterns, w), h.Hyphenate(w); !intArrayEqual(got, expected) {
+			t.Errorf("patterns %v break %q at %v, expected %v", patterns, w, got, expected)
+		}
+	}
+
+	var b bytes.Buffer
+	if err := h.WriteTeXPatterns(&b, []string{"θεός"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); got != "% ANCIENT_GREEK hyphenation patterns\n\\patterns{\nε1ό\n}\n" {
+		t.Errorf("WriteTeXPatterns() = %q", got)
+	}
+}
@@ -0,0 +1,196 @@
+package greekaccentuation
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// FoldLevel selects the diacritics an Index ignores.
+type FoldLevel int
+
+const (
+	// FOLD_ACCENTS ignores accents alone: λογος finds λόγος, but ὁρος
+	// does not find ὄρος.
+	FOLD_ACCENTS FoldLevel = 1
+	// FOLD_ALL also ignores breathings, the diaeresis and length marks.
+	FOLD_ALL FoldLevel = 2
+)
+
+func (e FoldLevel) Name() string {
+	switch e {
+	case FOLD_ACCENTS:
+		return "FOLD_ACCENTS"
+	case FOLD_ALL:
+		return "FOLD_ALL"
+	}
+	return ""
+}
+
+var stripDiaeresis = removeDiacritic(Diacritics)
+
+// Fold returns the key a word is stored under at a folding level: the
+// word in lower case with the ignored diacritics removed and ς written
+// as σ.
+func Fold(word string, level FoldLevel) string {
+	r := StripAccents([]rune(norm.NFC.String(word)))
+	if level >= FOLD_ALL {
+		r = stripLength(stripDiaeresis(stripBreathing(r)))
+	}
+	return strings.ReplaceAll(strings.ToLower(string(r)), "ς", "σ")
+}
+
+// IndexEntry is a form stored in an Index with the number of times it
+// was added.
+type IndexEntry struct {
+	Form  string
+	Count int
+}
+
+// trieNode is a node of the trie of folded keys. Its children are kept
+// sorted by rune.
+type trieNode struct {
+	letters  []rune
+	children []*trieNode
+	terminal bool
+}
+
+func (n *trieNode) child(r rune, create bool) *trieNode {
+	i := sort.Search(len(n.letters), func(i int) bool { return n.letters[i] >= r })
+	if i < len(n.letters) && n.letters[i] == r {
+		return n.children[i]
+	}
+	if !create {
+		return nil
+	}
+	c := &trieNode{}
+	n.letters = append(n.letters, 0)
+	n.children = append(n.children, nil)
+	copy(n.letters[i+1:], n.letters[i:])
+	copy(n.children[i+1:], n.children[i:])
+	n.letters[i] = r
+	n.children[i] = c
+	return c
+}
+
+// walk calls f for each key below n in rune order until f returns false.
+func (n *trieNode) walk(prefix []rune, f func(key string) bool) bool {
+	if n.terminal && !f(string(prefix)) {
+		return false
+	}
+	for i, c := range n.children {
+		if !c.walk(append(prefix, n.letters[i]), f) {
+			return false
+		}
+	}
+	return true
+}
+
+// Index stores words for lookup ignoring accents, and optionally other
+// diacritics, with a trie of the folded keys for prefix search. An Index
+// is safe for concurrent use; lookups may run in parallel.
+type Index struct {
+	mu     sync.RWMutex
+	level  FoldLevel
+	forms  map[string]map[string]int // folded key to forms and counts
+	root   trieNode
+	length int
+}
+
+func NewIndex(level FoldLevel) *Index {
+	return &Index{level: level, forms: map[string]map[string]int{}}
+}
+
+// Add adds one occurrence of a word.
+func (x *Index) Add(word string) {
+	x.AddCount(word, 1)
+}
+
+// AddCount adds count occurrences of a word.
+func (x *Index) AddCount(word string, count int) {
+	form := norm.NFC.String(word)
+	key := Fold(form, x.level)
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	forms, ok := x.forms[key]
+	if !ok {
+		forms = map[string]int{}
+		x.forms[key] = forms
+		n := &x.root
+		for _, r := range key {
+			n = n.child(r, true)
+		}
+		n.terminal = true
+	}
+	if _, ok := forms[form]; !ok {
+		x.length++
+	}
+	forms[form] += count
+}
+
+// Len returns the number of distinct forms in the index.
+func (x *Index) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return x.length
+}
+
+// entries returns the forms stored under a key, the most frequent first.
+// The read lock must be held.
+func (x *Index) entries(key string) []IndexEntry {
+	var result []IndexEntry
+	for form, count := range x.forms[key] {
+		result = append(result, IndexEntry{form, count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Form < result[j].Form
+	})
+	return result
+}
+
+// Exact returns the entry for exactly the form given.
+func (x *Index) Exact(word string) (IndexEntry, bool) {
+	form := norm.NFC.String(word)
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	count, ok := x.forms[Fold(form, x.level)][form]
+	return IndexEntry{form, count}, ok
+}
+
+// Lookup returns the forms that match a word at the folding level of the
+// index, the most frequent first: λογος finds λόγος and λογός.
+func (x *Index) Lookup(word string) []IndexEntry {
+	key := Fold(word, x.level)
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return x.entries(key)
+}
+
+// Prefix returns the forms whose folded key begins with the folded
+// prefix, ordered by key and then by frequency. At most limit entries
+// are returned, or all of them if limit is 0.
+func (x *Index) Prefix(prefix string, limit int) []IndexEntry {
+	key := Fold(prefix, x.level)
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	n := &x.root
+	for _, r := range key {
+		if n = n.child(r, false); n == nil {
+			return nil
+		}
+	}
+	var result []IndexEntry
+	n.walk([]rune(key), func(k string) bool {
+		result = append(result, x.entries(k)...)
+		return limit == 0 || len(result) < limit
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package greekaccentuation
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		word     string
+		level    FoldLevel
+		expected string
+	}{
+		{"λόγος", FOLD_ACCENTS, "λογοσ"},
+		{"Λόγος", FOLD_ACCENTS, "λογοσ"},
+		{"ὄρος", FOLD_ACCENTS, "ὀροσ"},
+		{"ὄρος", FOLD_ALL, "οροσ"},
+		{"προΐστημι", FOLD_ALL, "προιστημι"},
+		{"ᾱ̓́ρχω", FOLD_ALL, "αρχω"},
+	}
+	for _, test := range tests {
+		if got := Fold(test.word, test.level); got != test.expected {
+			t.Errorf("Fold(%q, %s) = %q, expected %q", test.word, test.level.Name(), got, test.expected)
+		}
+	}
+}
+
+func TestIndex(t *testing.T) {
+	x := NewIndex(FOLD_ACCENTS)
+	for _, w := range []string{"λόγος", "λόγος", "λόγου", "λογός", "λόγοι", "ὄρος", "ὅρος", "ὅρος", "λέγω"} {
+		x.Add(w)
+	}
+	x.AddCount("λόγος", 3)
+
+	if x.Len() != 7 {
+		t.Fatalf("Len() = %d, expected 7", x.Len())
+	}
+	if e, ok := x.Exact("λόγος"); !ok || e.Count != 5 {
+		t.Fatalf("Exact() failed. Returned %+v, %v", e, ok)
+	}
+	if _, ok := x.Exact("λογος"); ok {
+		t.Fatalf("Exact() matched an unaccented form")
+	}
+	if got := fmt.Sprint(x.Lookup("λογος")); got != "[{λόγος 5} {λογός 1}]" {
+		t.Fatalf("Lookup() failed. Returned %s", got)
+	}
+	if got := fmt.Sprint(x.Lookup("ὁρος")); got != "[{ὅρος 2}]" {
+		t.Fatalf("Lookup() failed. Returned %s", got)
+	}
+	if got := fmt.Sprint(x.Prefix("λογο", 0)); got != "[{λόγοι 1} {λόγος 5} {λογός 1} {λόγου 1}]" {
+		t.Fatalf("Prefix() failed. Returned %s", got)
+	}
+	if got := fmt.Sprint(x.Prefix("λογ", 2)); got != "[{λόγοι 1} {λόγος 5}]" {
+		t.Fatalf("Prefix() failed. Returned %s", got)
+	}
+	if got := x.Prefix("μ", 0); got != nil {
+		t.Fatalf("Prefix() failed. Returned %v", got)
+	}
+
+	all := NewIndex(FOLD_ALL)
+	all.Add("ὄρος")
+	all.Add("ὅρος")
+	if got := fmt.Sprint(all.Lookup("ορος")); got != "[{ὄρος 1} {ὅρος 1}]" {
+		t.Fatalf("Lookup() failed. Returned %s", got)
+	}
+}
+
+func TestIndexConcurrentReads(t *testing.T) {
+	x := NewIndex(FOLD_ALL)
+	for i := 0; i < 1000; i++ {
+		x.Add(fmt.Sprintf("λόγος%d", i))
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				x.Lookup("λογος1")
+				x.Prefix("λογοσ1", 10)
+				if i == 0 {
+					x.Add("λέγω")
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	if len(x.Prefix("λογοσ", 0)) != 1000 {
+		t.Fatalf("Prefix() failed")
+	}
+}
@@ -0,0 +1,89 @@
+package greekaccentuation
+
+import (
+	"errors"
+	"fmt"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrNotPrecomposed is returned by Compose for a letter that Unicode has
+// no single character for.
+var ErrNotPrecomposed = errors.New("greekaccentuation: no precomposed character")
+
+// Letter is a character split into its base letter and diacritics.
+type Letter struct {
+	Base          rune // lower case base letter
+	Breathing     Breathing
+	Accent        Accent
+	Diaeresis     bool
+	IotaSubscript bool
+	Length        Length // UNKNOWN if the letter has no length mark
+	Upper         bool
+}
+
+// Analyze splits a character into its base letter and diacritics.
+func Analyze(r rune) Letter {
+	base := Base(r)
+	l := Letter{
+		Base:   unicode.ToLower(base),
+		Length: UNKNOWN,
+		Upper:  unicode.IsUpper(base),
+	}
+	if b := breathing(r); b != nil {
+		l.Breathing = b.(Breathing)
+	}
+	if a := accent(r); a != nil {
+		l.Accent = a.(Accent)
+	}
+	if length := length(r); length != nil {
+		l.Length = length.(Length)
+	}
+	l.Diaeresis = diaeresis(r) != nil
+	l.IotaSubscript = iotaSubscript(r) != nil
+	return l
+}
+
+// decomposed returns the letter as a base character followed by its
+// combining marks.
+func (l Letter) decomposed() []rune {
+	base := l.Base
+	if l.Upper {
+		base = unicode.ToUpper(base)
+	}
+	d := []rune{base}
+	if l.Length == LONG || l.Length == SHORT {
+		d = append(d, l.Length.Rune())
+	}
+	if l.Diaeresis {
+		d = append(d, DIAERESIS.Rune())
+	}
+	if l.Breathing != NO_BREATHING {
+		d = append(d, l.Breathing.Rune())
+	}
+	if l.Accent != NO_ACCENT {
+		d = append(d, l.Accent.Rune())
+	}
+	if l.IotaSubscript {
+		d = append(d, IOTA.Rune())
+	}
+	return d
+}
+
+// String returns the letter in NFC, as a single character if there is
+// one and otherwise as a base character with combining marks.
+func (l Letter) String() string {
+	return norm.NFC.String(string(l.decomposed()))
+}
+
+// Compose returns the single character for a letter. If Unicode has none,
+// as for an α with both a macron and an accent, the error wraps
+// ErrNotPrecomposed and String gives the combining sequence.
+func Compose(l Letter) (rune, error) {
+	s := []rune(l.String())
+	if len(s) != 1 {
+		return 0, fmt.Errorf("%w: %q", ErrNotPrecomposed, string(s))
+	}
+	return s[0], nil
+}
@@ -0,0 +1,44 @@
+package greekaccentuation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := map[rune]Letter{
+		'α': {Base: 'α', Length: UNKNOWN},
+		'ᾅ': {Base: 'α', Breathing: ROUGH, Accent: ACUTE, IotaSubscript: true, Length: UNKNOWN},
+		'Ἄ': {Base: 'α', Breathing: SMOOTH, Accent: ACUTE, Length: UNKNOWN, Upper: true},
+		'ΐ': {Base: 'ι', Accent: ACUTE, Diaeresis: true, Length: UNKNOWN},
+		'ῡ': {Base: 'υ', Length: LONG},
+		'ῥ': {Base: 'ρ', Breathing: ROUGH, Length: UNKNOWN},
+		'ῼ': {Base: 'ω', IotaSubscript: true, Length: UNKNOWN, Upper: true},
+		'σ': {Base: 'σ', Length: UNKNOWN},
+	}
+	for r, expected := range tests {
+		if got := Analyze(r); got != expected {
+			t.Errorf("Analyze(%q) = %+v, expected %+v", r, got, expected)
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	for _, r := range "αᾅἌΐῡῥῼσὧᾯ" {
+		got, err := Compose(Analyze(r))
+		if err != nil || got != r {
+			t.Errorf("Compose(Analyze(%q)) = %q, %v", r, got, err)
+		}
+	}
+
+	l := Letter{Base: 'α', Breathing: SMOOTH, Accent: ACUTE, Length: LONG}
+	if _, err := Compose(l); !errors.Is(err, ErrNotPrecomposed) {
+		t.Fatalf("Compose() should fail for %+v, returned %v", l, err)
+	}
+	if l.String() != "ᾱ̓́" {
+		t.Fatalf("String() failed. Returned %q", l.String())
+	}
+	if r, err := Compose(Letter{Base: 'ε', Accent: CIRCUMFLEX}); err == nil {
+		t.Fatalf("Compose() should fail for ε with a circumflex, returned %q", r)
+	}
+}
@@ -0,0 +1,211 @@
+package greekaccentuation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MetricalPattern is a compiled pattern for a line of verse.
+//
+// A pattern is written as a sequence of positions separated by spaces:
+//
+//	–  -  —   a long position
+//	u  ∪  ⏑   a short position
+//	x  ×      an anceps position, long or short
+//	uu ⏕      a biceps position, two shorts or one long
+//	|         a foot boundary, used to group the positions of the scansion
+//
+// The final position of a line is always brevis in longo. For example the
+// glyconic is written "x x – u u – u –".
+type MetricalPattern struct {
+	Name   string
+	Source string
+	metre  metre
+}
+
+// patternToken maps each pattern token to the element sequences it may
+// stand for.
+func patternToken(token string) ([][]metricalElement, bool) {
+	switch token {
+	case "–", "-", "—":
+		return [][]metricalElement{{longElement}}, true
+	case "u", "∪", "⏑":
+		return [][]metricalElement{{shortElement}}, true
+	case "x", "×":
+		return [][]metricalElement{{ancepsElement}}, true
+	case "uu", "⏕":
+		return [][]metricalElement{{shortElement, shortElement}, {longElement}}, true
+	}
+	return nil, false
+}
+
+// CompilePattern compiles a metrical pattern. The name is used in error
+// messages and alignments, and may be empty.
+func CompilePattern(name, pattern string) (*MetricalPattern, error) {
+	var groups [][]string
+	var group []string
+	for _, token := range strings.Fields(strings.ReplaceAll(pattern, "|", " | ")) {
+		if token == "|" {
+			if len(group) > 0 {
+				groups = append(groups, group)
+			}
+			group = nil
+			continue
+		}
+		group = append(group, token)
+	}
+	if len(group) > 0 {
+		groups = append(groups, group)
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("greekaccentuation: empty metrical pattern %q", pattern)
+	}
+
+	label := name
+	if label == "" {
+		label = fmt.Sprintf("the pattern %q", pattern)
+	}
+	m := metre{name: label}
+	for g, group := range groups {
+		options := []footOption{{split: 1}}
+		for t, token := range group {
+			alternatives, ok := patternToken(token)
+			if !ok {
+				return nil, fmt.Errorf("greekaccentuation: unknown token %q in metrical pattern %q", token, pattern)
+			}
+			final := g == len(groups)-1 && t == len(group)-1
+			var next []footOption
+			for _, o := range options {
+				for _, a := range alternatives {
+					if final && len(a) == 1 {
+						a = []metricalElement{finalElement}
+					}
+					elements := append(append([]metricalElement{}, o.elements...), a...)
+					next = append(next, footOption{elements: elements, split: 1})
+				}
+			}
+			options = next
+		}
+		m.feet = append(m.feet, options)
+	}
+	return &MetricalPattern{Name: name, Source: pattern, metre: m}, nil
+}
+
+// Scan returns every reading of a line that fits the pattern. If the line
+// does not fit the error is a *ScansionError giving the reason.
+func (p *MetricalPattern) Scan(line string) ([]Scansion, error) {
+	return p.metre.scan(line)
+}
+
+var meters = struct {
+	sync.RWMutex
+	patterns map[string]*MetricalPattern
+}{patterns: map[string]*MetricalPattern{}}
+
+// RegisterMeter compiles a pattern and registers it under a name for
+// use by ScanLyric. Registering a name again replaces the earlier pattern.
+func RegisterMeter(name, pattern string) error {
+	p, err := CompilePattern(name, pattern)
+	if err != nil {
+		return err
+	}
+	meters.Lock()
+	defer meters.Unlock()
+	meters.patterns[name] = p
+	return nil
+}
+
+// LookupMeter returns the pattern registered under a name.
+func LookupMeter(name string) (*MetricalPattern, bool) {
+	meters.RLock()
+	defer meters.RUnlock()
+	p, ok := meters.patterns[name]
+	return p, ok
+}
+
+// Meters returns the names of the registered meters in alphabetical order.
+func Meters() []string {
+	meters.RLock()
+	defer meters.RUnlock()
+	var names []string
+	for name := range meters.patterns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func init() {
+	for _, m := range [][2]string{
+		{"glyconic", "x x | – u u – | u –"},
+		{"pherecratean", "x x | – u u – | –"},
+		{"telesillean", "x | – u u – | u –"},
+		{"reizianum", "x | – u u – | –"},
+		{"sapphic hendecasyllable", "– u – x | – u u – | u – –"},
+		{"adonean", "– u u | – –"},
+		{"alcaic hendecasyllable", "x – u – x | – u u – | u –"},
+		{"alcaic enneasyllable", "x – u – x – u – –"},
+		{"alcaic decasyllable", "– u u – u u | – u – –"},
+		{"lesser asclepiad", "– – | – u u – | – u u – | u –"},
+		{"greater asclepiad", "– – | – u u – | – u u – | – u u – | u –"},
+	} {
+		if err := RegisterMeter(m[0], m[1]); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Alignment is a reading of a line in a named meter.
+type Alignment struct {
+	Meter string
+	Scansion
+}
+
+// ScanLyric matches a line against the named meters, or against every
+// registered meter if none are named, and returns the best alignments.
+// Alignments that leave the metre to decide fewer syllables of unknown
+// length are preferred. If no meter fits the error lists the reason
+// for each meter tried.
+func ScanLyric(line string, names ...string) ([]Alignment, error) {
+	if len(names) == 0 {
+		names = Meters()
+	}
+	var alignments []Alignment
+	var reasons []string
+	for _, name := range names {
+		p, ok := LookupMeter(name)
+		if !ok {
+			return nil, fmt.Errorf("greekaccentuation: unknown meter %q", name)
+		}
+		scansions, err := p.Scan(line)
+		if err != nil {
+			if e, ok := err.(*ScansionError); ok {
+				reasons = append(reasons, e.Reason)
+				continue
+			}
+			return nil, err
+		}
+		for _, s := range scansions {
+			alignments = append(alignments, Alignment{name, s})
+		}
+	}
+	if len(alignments) == 0 {
+		return nil, &ScansionError{line, strings.Join(reasons, "; ")}
+	}
+
+	best := len(alignments[0].Dichrona)
+	for _, a := range alignments {
+		if len(a.Dichrona) < best {
+			best = len(a.Dichrona)
+		}
+	}
+	var result []Alignment
+	for _, a := range alignments {
+		if len(a.Dichrona) == best {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,91 @@
+package greekaccentuation
+
+import "testing"
+
+func TestCompilePattern(t *testing.T) {
+	p, err := CompilePattern("glyconic", "x x | – u u – | u –")
+	if err != nil {
+		t.Fatalf("CompilePattern() failed: %v", err)
+	}
+	if len(p.metre.feet) != 3 {
+		t.Fatalf("CompilePattern() failed. Returned %d feet", len(p.metre.feet))
+	}
+	if min, max := p.metre.syllableRange(); min != 8 || max != 8 {
+		t.Fatalf("CompilePattern() failed. Returned range %d, %d", min, max)
+	}
+	last := p.metre.feet[2][0].elements
+	if last[len(last)-1] != finalElement {
+		t.Fatal("CompilePattern() failed. Final position should be brevis in longo")
+	}
+
+	p, err = CompilePattern("", "– uu – uu – –")
+	if err != nil {
+		t.Fatalf("CompilePattern() failed: %v", err)
+	}
+	if min, max := p.metre.syllableRange(); min != 6 || max != 8 {
+		t.Fatalf("CompilePattern() failed. Returned range %d, %d", min, max)
+	}
+
+	if _, err := CompilePattern("", "x y –"); err == nil {
+		t.Fatal("CompilePattern() failed. Expected an error for an unknown token")
+	}
+	if _, err := CompilePattern("", " | "); err == nil {
+		t.Fatal("CompilePattern() failed. Expected an error for an empty pattern")
+	}
+}
+
+func TestScanLyric(t *testing.T) {
+	r, err := ScanLyric("ποικιλόθρον᾽ ἀθανάτ᾽ Ἀφρόδιτα,")
+	if err != nil {
+		t.Fatalf("ScanLyric() failed: %v", err)
+	}
+	if len(r) != 1 || r[0].Meter != "sapphic hendecasyllable" || r[0].Pattern() != "–u–u|–uu–|u––" {
+		t.Fatalf("ScanLyric() failed. Returned %v", r)
+	}
+	if !intArrayEqual(r[0].Anceps, []int{3}) {
+		t.Fatalf("ScanLyric() failed. Returned anceps %v", r[0].Anceps)
+	}
+
+	r, err = ScanLyric("παῖ Δίος δολόπλοκε, λίσσομαί σε,", "sapphic hendecasyllable")
+	if err != nil {
+		t.Fatalf("ScanLyric() failed: %v", err)
+	}
+	if len(r) != 1 || !r[0].BrevisInLongo {
+		t.Fatalf("ScanLyric() failed. Returned %v", r)
+	}
+
+	r, err = ScanLyric("πότνια, θῦμον", "adonean", "glyconic")
+	if err != nil {
+		t.Fatalf("ScanLyric() failed: %v", err)
+	}
+	if len(r) != 1 || r[0].Meter != "adonean" || !intArrayEqual(r[0].Dichrona, []int{1, 2}) {
+		t.Fatalf("ScanLyric() failed. Returned %v", r)
+	}
+
+	if _, err := ScanLyric("πότνια, θῦμον", "glyconic"); err == nil {
+		t.Fatal("ScanLyric() failed. Expected an error")
+	}
+	if _, err := ScanLyric("πότνια, θῦμον", "limerick"); err == nil {
+		t.Fatal("ScanLyric() failed. Expected an error for an unknown meter")
+	}
+}
+
+func TestRegisterMeter(t *testing.T) {
+	if err := RegisterMeter("test dimeter", "x – u – | x – u –"); err != nil {
+		t.Fatalf("RegisterMeter() failed: %v", err)
+	}
+	defer func() {
+		meters.Lock()
+		delete(meters.patterns, "test dimeter")
+		meters.Unlock()
+	}()
+	if _, ok := LookupMeter("test dimeter"); !ok {
+		t.Fatal("LookupMeter() failed")
+	}
+	if err := RegisterMeter("broken", "– ? –"); err == nil {
+		t.Fatal("RegisterMeter() failed. Expected an error")
+	}
+	if _, ok := LookupMeter("broken"); ok {
+		t.Fatal("RegisterMeter() failed. Registered a broken pattern")
+	}
+}
@@ -0,0 +1,127 @@
+package greekaccentuation
+
+var (
+	dactylOrSpondee = []footOption{
+		plainFoot(longElement, shortElement, shortElement),
+		plainFoot(longElement, longElement),
+	}
+	dactylOnly = []footOption{
+		plainFoot(longElement, shortElement, shortElement),
+	}
+	singleLong  = []footOption{plainFoot(longElement)}
+	singleFinal = []footOption{plainFoot(finalElement)}
+
+	// The first foot of an iambic metron has an anceps position. Either
+	// position may be resolved into two shorts.
+	iambicAncepsFoot = []footOption{
+		plainFoot(ancepsElement, longElement),
+		{elements: []metricalElement{ancepsElement, shortElement, shortElement}, split: 1, resolution: true},
+		{elements: []metricalElement{shortElement, shortElement, longElement}, split: 2, resolution: true},
+	}
+	iambicFoot = []footOption{
+		plainFoot(shortElement, longElement),
+		{elements: []metricalElement{shortElement, shortElement, shortElement}, split: 1, resolution: true},
+	}
+	iambicFinalFoot = []footOption{plainFoot(shortElement, finalElement)}
+)
+
+var hexameter = metre{
+	name: "a hexameter",
+	feet: [][]footOption{
+		dactylOrSpondee, dactylOrSpondee, dactylOrSpondee, dactylOrSpondee, dactylOrSpondee,
+		{plainFoot(longElement, finalElement)},
+	},
+	annotate: func(s *Scansion, m matched) {
+		if m.wordFinal(m.starts[2]) {
+			s.Caesurae = append(s.Caesurae, CaesuraPosition{PENTHEMIMERAL, m.starts[2]})
+		} else if s.Feet[2] == DACTYL && m.wordFinal(m.starts[2]+1) {
+			s.Caesurae = append(s.Caesurae, CaesuraPosition{TROCHAIC, m.starts[2] + 1})
+		}
+		if m.wordFinal(m.starts[3]) {
+			s.Caesurae = append(s.Caesurae, CaesuraPosition{HEPHTHEMIMERAL, m.starts[3]})
+		}
+		if m.wordFinal(m.starts[4] - 1) {
+			s.Caesurae = append(s.Caesurae, CaesuraPosition{BUCOLIC_DIAERESIS, m.starts[4] - 1})
+		}
+	},
+}
+
+var pentameter = metre{
+	name: "a pentameter",
+	feet: [][]footOption{
+		dactylOrSpondee, dactylOrSpondee, singleLong,
+		dactylOnly, dactylOnly, singleFinal,
+	},
+	annotate: func(s *Scansion, m matched) {
+		middle := m.starts[3] - 1
+		if m.wordFinal(middle) {
+			s.Caesurae = append(s.Caesurae, CaesuraPosition{MEDIAN_DIAERESIS, middle})
+		} else {
+			s.Violations = append(s.Violations, ViolationPosition{MISSING_DIAERESIS, middle})
+		}
+	},
+}
+
+var iambicTrimeter = metre{
+	name: "an iambic trimeter",
+	feet: [][]footOption{
+		iambicAncepsFoot, iambicFoot,
+		iambicAncepsFoot, iambicFoot,
+		iambicAncepsFoot, iambicFinalFoot,
+	},
+	annotate: func(s *Scansion, m matched) {
+		if p := m.firstPositionEnd(2); m.wordFinal(p) {
+			s.Caesurae = append(s.Caesurae, CaesuraPosition{PENTHEMIMERAL, p})
+		}
+		if p := m.firstPositionEnd(3); m.wordFinal(p) {
+			s.Caesurae = append(s.Caesurae, CaesuraPosition{HEPHTHEMIMERAL, p})
+		}
+		// Porson's bridge: a long third anceps may not end a word of
+		// more than one syllable.
+		if p := m.firstPositionEnd(4); m.feet[4].split == 1 && s.Quantities[p] == LONG &&
+			m.wordFinal(p) && p > 0 && m.words[p-1] == m.words[p] {
+			s.Violations = append(s.Violations, ViolationPosition{PORSONS_BRIDGE, p})
+		}
+	},
+}
+
+// ScanHexameter returns every reading of a line as dactylic hexameter.
+// Syllables are resyllabified across word boundaries and made long by
+// position before two consonants (ζ ξ ψ count as two). A stop followed
+// by a liquid may leave a syllable short, and a long vowel before a word
+// beginning with a vowel may be shortened (epic correption). Synizesis
+// is only used where the line will not scan without it, and then as few
+// times as possible. If the line cannot be scanned the error is a
+// *ScansionError giving the reason.
+func ScanHexameter(line string) ([]Scansion, error) {
+	return hexameter.scan(line)
+}
+
+// ScanPentameter returns every reading of a line as the pentameter of an
+// elegiac couplet: two dactyls or spondees and a long, then two dactyls
+// and a final position. The median diaeresis is reported as a caesura,
+// or as a violation if no word ends there.
+func ScanPentameter(line string) ([]Scansion, error) {
+	return pentameter.scan(line)
+}
+
+// ScanElegiacCouplet scans a hexameter and the pentameter that follows it.
+func ScanElegiacCouplet(hexameterLine, pentameterLine string) ([]Scansion, []Scansion, error) {
+	h, err := ScanHexameter(hexameterLine)
+	if err != nil {
+		return nil, nil, err
+	}
+	p, err := ScanPentameter(pentameterLine)
+	if err != nil {
+		return nil, nil, err
+	}
+	return h, p, nil
+}
+
+// ScanIambicTrimeter returns every reading of a line as iambic trimeter.
+// The anceps positions, any resolutions of a position into two shorts,
+// the penthemimeral and hephthemimeral caesurae and breaches of Porson's
+// bridge are reported on each scansion.
+func ScanIambicTrimeter(line string) ([]Scansion, error) {
+	return iambicTrimeter.scan(line)
+}
@@ -0,0 +1,88 @@
+package greekaccentuation
+
+import "testing"
+
+func TestScanIambicTrimeter(t *testing.T) {
+	r, err := ScanIambicTrimeter("ὦ τέκνα, Κάδμου τοῦ πάλαι νέα τροφή")
+	if err != nil {
+		t.Fatalf("ScanIambicTrimeter() failed: %v", err)
+	}
+	if len(r) != 1 || r[0].Pattern() != "––|u–|––|u–|u–|u–" {
+		t.Fatalf("ScanIambicTrimeter() failed. Returned %v", r)
+	}
+	if !intArrayEqual(r[0].Anceps, []int{0, 4, 8}) {
+		t.Fatalf("ScanIambicTrimeter() failed. Returned anceps %v", r[0].Anceps)
+	}
+	if !intArrayEqual(r[0].Dichrona, []int{2, 6}) {
+		t.Fatalf("ScanIambicTrimeter() failed. Returned dichrona %v", r[0].Dichrona)
+	}
+	if len(r[0].Caesurae) != 1 || r[0].Caesurae[0].Caesura != PENTHEMIMERAL {
+		t.Fatalf("ScanIambicTrimeter() failed. Returned caesurae %v", r[0].Caesurae)
+	}
+	if len(r[0].Violations) != 0 {
+		t.Fatalf("ScanIambicTrimeter() failed. Returned violations %v", r[0].Violations)
+	}
+
+	// An elided consonant makes the syllable before it long by position
+	r, err = ScanIambicTrimeter("πόλις δ᾽ ὁμοῦ μὲν θυμιαμάτων γέμει")
+	if err != nil {
+		t.Fatalf("ScanIambicTrimeter() failed: %v", err)
+	}
+	if len(r) != 1 || r[0].Pattern() != "u–|u–|––|u–|––|u–" {
+		t.Fatalf("ScanIambicTrimeter() failed. Returned %v", r)
+	}
+
+	r, err = ScanIambicTrimeter("ὦ τέκνα, Κάδμου τοῦ θεοῖσιν τοῦτο δή")
+	if err != nil {
+		t.Fatalf("ScanIambicTrimeter() failed: %v", err)
+	}
+	if len(r) != 1 || len(r[0].Violations) != 1 || r[0].Violations[0].Violation != PORSONS_BRIDGE {
+		t.Fatalf("ScanIambicTrimeter() failed. Returned %v", r)
+	}
+}
+
+func TestScanIambicTrimeterResolution(t *testing.T) {
+	// ποτε resolves the first long position into two shorts
+	r, err := ScanIambicTrimeter("τί ποτε λέγεις; οὐ γὰρ σαφῶς λέγεις λόγον")
+	if err != nil {
+		t.Fatalf("ScanIambicTrimeter() failed: %v", err)
+	}
+	found := false
+	for _, s := range r {
+		if intArrayEqual(s.Resolutions, []int{0}) && s.Pattern()[:len("–uu")] == "–uu" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("ScanIambicTrimeter() failed. No resolution found in %v", r)
+	}
+}
+
+func TestScanElegiacCouplet(t *testing.T) {
+	h, p, err := ScanElegiacCouplet("ὦ ξεῖν᾽, ἀγγέλλειν Λακεδαιμονίοις ὅτι τῇδε",
+		"κείμεθα, τοῖς κείνων ῥήμασι πειθόμενοι.")
+	if err != nil {
+		t.Fatalf("ScanElegiacCouplet() failed: %v", err)
+	}
+	if len(h) != 1 || h[0].Pattern() != "––|––|–uu|–uu|–uu|–u" || !h[0].BrevisInLongo {
+		t.Fatalf("ScanElegiacCouplet() failed. Returned %v", h)
+	}
+	if len(p) != 1 || p[0].Pattern() != "–uu|––|–|–uu|–uu|–" {
+		t.Fatalf("ScanElegiacCouplet() failed. Returned %v", p)
+	}
+	if len(p[0].Caesurae) != 1 || p[0].Caesurae[0] != (CaesuraPosition{MEDIAN_DIAERESIS, 5}) {
+		t.Fatalf("ScanElegiacCouplet() failed. Returned caesurae %v", p[0].Caesurae)
+	}
+}
+
+func intArrayEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
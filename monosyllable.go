@@ -0,0 +1,77 @@
+package greekaccentuation
+
+import "sync"
+
+// oblique reports whether an inflection is a genitive or dative.
+func (e Inflection) oblique() bool {
+	switch e {
+	case GENITIVE_SINGULAR, GENITIVE_PLURAL, DATIVE_SINGULAR, DATIVE_PLURAL:
+		return true
+	}
+	return false
+}
+
+// monosyllableExceptions holds the third declension monosyllables that
+// keep the accent on the stem in some oblique cases, keyed by foldName
+// of the lemma.
+var monosyllableExceptions = struct {
+	sync.RWMutex
+	lemmas map[string]map[Inflection]bool
+}{lemmas: map[string]map[Inflection]bool{}}
+
+// RegisterMonosyllableException records that a third declension
+// monosyllable keeps the accent of its stem in the inflections given,
+// rather than accenting the ultima of the genitive and dative (παῖς,
+// παίδων but παιδός).
+func RegisterMonosyllableException(lemma string, inflections ...Inflection) {
+	key := foldName(lemma)
+	monosyllableExceptions.Lock()
+	defer monosyllableExceptions.Unlock()
+	m, ok := monosyllableExceptions.lemmas[key]
+	if !ok {
+		m = map[Inflection]bool{}
+		monosyllableExceptions.lemmas[key] = m
+	}
+	for _, i := range inflections {
+		m[i] = true
+	}
+}
+
+// isMonosyllableException reports whether a lemma keeps its accent in an
+// inflection.
+func isMonosyllableException(lemma string, inflection Inflection) bool {
+	monosyllableExceptions.RLock()
+	defer monosyllableExceptions.RUnlock()
+	return monosyllableExceptions.lemmas[foldName(lemma)][inflection]
+}
+
+func init() {
+	// Smyth §252: the genitive plural keeps the accent of the stem
+	for _, lemma := range []string{"παῖς", "δμώς", "θώς", "Τρώς", "φῴς", "δᾴς", "οὖς"} {
+		RegisterMonosyllableException(lemma, GENITIVE_PLURAL)
+	}
+	// and πᾶς the dative plural as well (πάντων, πᾶσι)
+	RegisterMonosyllableException("πᾶς", GENITIVE_PLURAL, DATIVE_PLURAL)
+	// Monosyllabic participles keep the accent of the nominative in
+	// every case (ὄντος, στάντος, δόντων), Smyth §308
+	for _, lemma := range []string{"ὤν", "βάς", "στάς", "φάς", "τλάς", "θείς", "εἵς", "δούς", "γνούς", "δύς", "φύς"} {
+		RegisterMonosyllableException(lemma, GENITIVE_SINGULAR, GENITIVE_PLURAL, DATIVE_SINGULAR, DATIVE_PLURAL)
+	}
+}
+
+// monosyllableAccentuation returns the accentuation of the genitive or
+// dative of a third declension monosyllable, with the name of the rule,
+// or an empty rule if the persistent accent stands. The accent falls on
+// the ultima, a circumflex if it is long (φλέψ, φλεβός, φλεβῶν, φλεψί).
+func (o Options) monosyllableAccentuation(lemma string, s []string, ultimaLength Length) (string, Accentuation) {
+	if !o.Inflection.oblique() || len(s) < 2 || len(Syllabify(lemma)) != 1 {
+		return "", NO_ACCENTUATION
+	}
+	if isMonosyllableException(lemma, o.Inflection) {
+		return "", NO_ACCENTUATION
+	}
+	if ultimaLength == LONG {
+		return "third declension monosyllable", PERISPOMENON
+	}
+	return "third declension monosyllable", OXYTONE
+}
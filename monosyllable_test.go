@@ -0,0 +1,85 @@
+package greekaccentuation
+
+import "testing"
+
+func TestMonosyllableAccentuation(t *testing.T) {
+	tests := []struct {
+		word, lemma string
+		inflection  Inflection
+		expected    string
+	}{
+		{"φλεβος", "φλέψ", GENITIVE_SINGULAR, "φλεβός"},
+		{"φλεβι", "φλέψ", DATIVE_SINGULAR, "φλεβί"},
+		{"φλεβων", "φλέψ", GENITIVE_PLURAL, "φλεβῶν"},
+		{"φλεψι", "φλέψ", DATIVE_PLURAL, "φλεψί"},
+		{"φλεβα", "φλέψ", NO_INFLECTION, "φλέβα"},
+		{"νυκτος", "νύξ", GENITIVE_SINGULAR, "νυκτός"},
+		{"νυκτων", "νύξ", GENITIVE_PLURAL, "νυκτῶν"},
+		{"νυξι", "νύξ", DATIVE_PLURAL, "νυξί"},
+		{"ποδων", "πούς", GENITIVE_PLURAL, "ποδῶν"},
+		// exceptions
+		{"παιδος", "παῖς", GENITIVE_SINGULAR, "παιδός"},
+		{"παιδων", "παῖς", GENITIVE_PLURAL, "παίδων"},
+		{"παισι", "παῖς", DATIVE_PLURAL, "παισί"},
+		{"ὠτος", "οὖς", GENITIVE_SINGULAR, "ὠτός"},
+		{"ὠτων", "οὖς", GENITIVE_PLURAL, "ὤτων"},
+		{"ὠσι", "οὖς", DATIVE_PLURAL, "ὠσί"},
+		{"δᾳδων", "δᾴς", GENITIVE_PLURAL, "δᾴδων"},
+		{"δᾳσι", "δᾴς", DATIVE_PLURAL, "δᾳσί"},
+		{"παντων", "πᾶς", GENITIVE_PLURAL, "πάντων"},
+		{"πασι", "πᾶς", DATIVE_PLURAL, "πᾶσι"},
+		{"ὀντος", "ὤν", GENITIVE_SINGULAR, "ὄντος"},
+		{"σταντος", "στάς", GENITIVE_SINGULAR, "στάντος"},
+		{"δοντων", "δούς", GENITIVE_PLURAL, "δόντων"},
+		{"θεντι", "θείς", DATIVE_SINGULAR, "θέντι"},
+		{"γνοντων", "γνούς", GENITIVE_PLURAL, "γνόντων"},
+		{"βαντων", "βάς", GENITIVE_PLURAL, "βάντων"},
+		// not monosyllables
+		{"σωματος", "σῶμα", GENITIVE_SINGULAR, "σώματος"},
+		{"ἀνθρωπου", "ἄνθρωπος", GENITIVE_SINGULAR, "ἀνθρώπου"},
+	}
+	for _, test := range tests {
+		got := PersistentWith(test.word, test.lemma, Options{Inflection: test.inflection})
+		if got != test.expected {
+			t.Errorf("PersistentWith(%q, %q, %s) = %q, expected %q",
+				test.word, test.lemma, test.inflection.Name(), got, test.expected)
+		}
+	}
+
+	// Aeolic is recessive in every case
+	got := PersistentWith("φλεβος", "φλέψ", Options{Dialect: AEOLIC, Inflection: GENITIVE_SINGULAR})
+	if got != "φλέβος" {
+		t.Errorf("PersistentWith(%q) in Aeolic = %q", "φλεβος", got)
+	}
+}
+
+// restoreMonosyllableExceptions puts back the registered exceptions as
+// they were when the test began.
+func restoreMonosyllableExceptions(t *testing.T) {
+	monosyllableExceptions.RLock()
+	saved := map[string]map[Inflection]bool{}
+	for lemma, inflections := range monosyllableExceptions.lemmas {
+		saved[lemma] = map[Inflection]bool{}
+		for i := range inflections {
+			saved[lemma][i] = true
+		}
+	}
+	monosyllableExceptions.RUnlock()
+	t.Cleanup(func() {
+		monosyllableExceptions.Lock()
+		monosyllableExceptions.lemmas = saved
+		monosyllableExceptions.Unlock()
+	})
+}
+
+func TestRegisterMonosyllableException(t *testing.T) {
+	restoreMonosyllableExceptions(t)
+	options := Options{Inflection: GENITIVE_PLURAL}
+	if got := PersistentWith("σεων", "σής", options); got != "σεῶν" {
+		t.Fatalf("PersistentWith() = %q", got)
+	}
+	RegisterMonosyllableException("σής", GENITIVE_PLURAL)
+	if got := PersistentWith("σεων", "σής", options); got != "σέων" {
+		t.Errorf("PersistentWith() after RegisterMonosyllableException = %q", got)
+	}
+}
@@ -0,0 +1,84 @@
+package greekaccentuation
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NameLexicon is a set of indeclinable foreign names, mostly Semitic
+// names in the Septuagint and New Testament. The names are kept as the
+// edition prints them, which may be without an accent. A NameLexicon is
+// safe for concurrent use.
+type NameLexicon struct {
+	mu    sync.RWMutex
+	names map[string]string
+}
+
+func NewNameLexicon(names ...string) *NameLexicon {
+	l := &NameLexicon{names: map[string]string{}}
+	for _, name := range names {
+		l.Add(name)
+	}
+	return l
+}
+
+// IndeclinableNames holds common indeclinable names as printed in modern
+// editions of the New Testament.
+var IndeclinableNames = NewNameLexicon(
+	"Ἀαρών", "Ἀβαδδών", "Ἀβραάμ", "Ἀδάμ", "Ἀμιναδάβ", "Βηθλέεμ",
+	"Γαβριήλ", "Δαυίδ", "Ἐλιακίμ", "Ἐμμανουήλ", "Ἰακώβ", "Ἰαρέδ",
+	"Ἰερουσαλήμ", "Ἰσαάκ", "Ἰσραήλ", "Ἰωσήφ", "Μελχισέδεκ", "Μιχαήλ",
+	"Ναζαρέθ", "Ῥαχήλ", "Σαλαθιήλ", "Σιών", "Συμεών", "Ζοροβαβέλ",
+)
+
+// foldName returns the key a name is stored under: the name in lower
+// case without diacritics.
+func foldName(name string) string {
+	var b strings.Builder
+	for _, ch := range norm.NFD.String(name) {
+		if isCombiningMark(ch) {
+			continue
+		}
+		if ch == 'ς' {
+			ch = 'σ'
+		}
+		b.WriteRune(unicode.ToLower(ch))
+	}
+	return b.String()
+}
+
+// Add adds a name to the lexicon, replacing any name spelt with the same
+// letters.
+func (l *NameLexicon) Add(name string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.names[foldName(name)] = norm.NFC.String(name)
+}
+
+// Lookup returns the name in the lexicon spelt with the same letters as
+// word, whatever its accent and breathing.
+func (l *NameLexicon) Lookup(word string) (string, bool) {
+	if l == nil {
+		return "", false
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	name, ok := l.names[foldName(word)]
+	return name, ok
+}
+
+// Names returns the names in the lexicon in alphabetical order.
+func (l *NameLexicon) Names() []string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	var names []string
+	for _, name := range l.names {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,54 @@
+package greekaccentuation
+
+import (
+	"testing"
+)
+
+func TestNameLexicon(t *testing.T) {
+	l := NewNameLexicon("Ἀαρών", "Ααρων")
+	if name, ok := l.Lookup("ἀαρων"); !ok || name != "Ααρων" {
+		t.Fatalf("Lookup() failed. Returned %q, %v", name, ok)
+	}
+	if _, ok := l.Lookup("Ἀβραάμ"); ok {
+		t.Fatalf("Lookup() found a name not in the lexicon")
+	}
+	if len(l.Names()) != 1 {
+		t.Fatalf("Names() failed. Returned %v", l.Names())
+	}
+	if name, ok := IndeclinableNames.Lookup("ΙΣΡΑΗΛ"); !ok || name != "Ἰσραήλ" {
+		t.Fatalf("Lookup() failed. Returned %q, %v", name, ok)
+	}
+	var nilLexicon *NameLexicon
+	if _, ok := nilLexicon.Lookup("Ἀαρών"); ok {
+		t.Fatalf("Lookup() on a nil lexicon found a name")
+	}
+}
+
+func TestForeignNames(t *testing.T) {
+	options := Options{Names: IndeclinableNames}
+	tests := []struct {
+		word, lemma string
+		options     Options
+		expected    string
+	}{
+		{"Ααρων", "Ααρων", Options{}, ""},
+		{"Ααρων", "Ααρων", options, "Ἀαρών"},
+		{"Ἀβρααμ", "Ἀβραάμ", options, "Ἀβραάμ"},
+		{"Ἰσραηλ", "", options, "Ἰσραήλ"},
+		{"Ἰακωβου", "Ἰάκωβος", options, "Ἰακώβου"},
+		{"Ααρων", "Ααρων", Options{Names: NewNameLexicon("Ααρων")}, "Ααρων"},
+		{"Μελχισεδεκ", "Μελχισέδεκ", options, "Μελχισέδεκ"},
+	}
+	for _, test := range tests {
+		if got := PersistentWith(test.word, test.lemma, test.options); got != test.expected {
+			t.Errorf("PersistentWith(%q, %q) = %q, expected %q", test.word, test.lemma, got, test.expected)
+		}
+	}
+
+	if got := RecessiveWith("Μελχισεδεκ", options); got != "Μελχισέδεκ" {
+		t.Errorf("RecessiveWith() failed. Returned %q", got)
+	}
+	if got := RecessiveWith("Μελχισεδεκ", Options{}); got != "Μελχίσεδεκ" {
+		t.Errorf("RecessiveWith() failed. Returned %q", got)
+	}
+}
@@ -0,0 +1,55 @@
+package greekaccentuation
+
+import (
+	"golang.org/x/text/unicode/norm"
+)
+
+// Options controls the exported accentuation functions. The zero value
+// gives the Attic rules with final -αι and -οι counted as short. This is
+// not what Persistent does, which counts them as long; set
+// FinalDiphthongsLong to follow it.
+type Options struct {
+	// FinalDiphthongsLong counts a final -αι or -οι as long, as in the
+	// optative and the locative. Otherwise they are short for the accent.
+	FinalDiphthongsLong bool
+	// DefaultShort treats a syllable of unknown length as short.
+	DefaultShort bool
+	// LengthResolver, if set, is asked for the length of a syllable
+	// whose vowel length cannot be told from its spelling. The final
+	// argument is true for the ultima. If it returns UNKNOWN the
+	// DefaultShort rule applies.
+	LengthResolver func(syllable string, final bool) Length
+	// Form is the normalization form of the words returned. The zero
+	// value is NFC.
+	Form    norm.Form
+	Dialect Dialect
+	// Inflection identifies the form of the word where the dialect or
+	// the declension accents some forms differently.
+	Inflection Inflection
+	// Names, if set, is a lexicon of indeclinable foreign names. A word
+	// or lemma found in it is returned as the lexicon spells it, accented
+	// or not, without applying the law of limitation.
+	Names *NameLexicon
+}
+
+// lengths returns the lengths of the ultima and penult of a word that
+// decide which accentuations are possible.
+func (o Options) lengths(s []string) (Length, Length) {
+	long := o.FinalDiphthongsLong || o.Dialect == DORIC
+	ultimaLength, penultLength := accentLengths(s, !long, false)
+	if o.LengthResolver != nil {
+		if ultimaLength == UNKNOWN {
+			ultimaLength = o.LengthResolver(s[len(s)-1], true)
+		}
+		if len(s) >= 2 && penultLength == UNKNOWN {
+			penultLength = o.LengthResolver(s[len(s)-2], false)
+		}
+	}
+	if ultimaLength == UNKNOWN && o.DefaultShort {
+		ultimaLength = SHORT
+	}
+	if len(s) >= 2 && penultLength == UNKNOWN && o.DefaultShort {
+		penultLength = SHORT
+	}
+	return ultimaLength, penultLength
+}
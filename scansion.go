@@ -0,0 +1,637 @@
+package greekaccentuation
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+type Foot int
+
+const (
+	NO_FOOT  Foot = 0
+	DACTYL   Foot = 1 // – u u
+	SPONDEE  Foot = 2 // – –
+	IAMB     Foot = 3 // u –
+	TRIBRACH Foot = 4 // u u u
+	ANAPAEST Foot = 5 // u u –
+	LONGUM   Foot = 6 // a single long position
+	TROCHEE  Foot = 7 // – u
+	CRETIC   Foot = 8 // – u –
+	CHORIAMB Foot = 9 // – u u –
+)
+
+func (e Foot) Name() string {
+	switch e {
+	case DACTYL:
+		return "DACTYL"
+	case SPONDEE:
+		return "SPONDEE"
+	case IAMB:
+		return "IAMB"
+	case TRIBRACH:
+		return "TRIBRACH"
+	case ANAPAEST:
+		return "ANAPAEST"
+	case LONGUM:
+		return "LONGUM"
+	case TROCHEE:
+		return "TROCHEE"
+	case CRETIC:
+		return "CRETIC"
+	case CHORIAMB:
+		return "CHORIAMB"
+	}
+	return ""
+}
+
+// size returns the number of syllables in a foot without resolution.
+func (e Foot) size() int {
+	switch e {
+	case LONGUM:
+		return 1
+	case SPONDEE, IAMB, TROCHEE:
+		return 2
+	case DACTYL, TRIBRACH, ANAPAEST, CRETIC:
+		return 3
+	case CHORIAMB:
+		return 4
+	}
+	return 0
+}
+
+// footFromQuantities names the foot made up of the given quantities.
+func footFromQuantities(q []Length) Foot {
+	var b strings.Builder
+	for _, l := range q {
+		if l == LONG {
+			b.WriteString("L")
+		} else {
+			b.WriteString("S")
+		}
+	}
+	switch b.String() {
+	case "LSS":
+		return DACTYL
+	case "LL":
+		return SPONDEE
+	case "SL":
+		return IAMB
+	case "SSS":
+		return TRIBRACH
+	case "SSL":
+		return ANAPAEST
+	case "L", "S":
+		return LONGUM
+	case "LS":
+		return TROCHEE
+	case "LSL":
+		return CRETIC
+	case "LSSL":
+		return CHORIAMB
+	}
+	return NO_FOOT
+}
+
+type Caesura int
+
+const (
+	NO_CAESURA        Caesura = 0
+	PENTHEMIMERAL     Caesura = 1 // after the fifth position
+	TROCHAIC          Caesura = 2 // after the first short of a third foot dactyl
+	HEPHTHEMIMERAL    Caesura = 3 // after the seventh position
+	BUCOLIC_DIAERESIS Caesura = 4 // between the fourth and fifth feet of a hexameter
+	MEDIAN_DIAERESIS  Caesura = 5 // between the two halves of a pentameter
+)
+
+func (e Caesura) Name() string {
+	switch e {
+	case PENTHEMIMERAL:
+		return "PENTHEMIMERAL"
+	case TROCHAIC:
+		return "TROCHAIC"
+	case HEPHTHEMIMERAL:
+		return "HEPHTHEMIMERAL"
+	case BUCOLIC_DIAERESIS:
+		return "BUCOLIC_DIAERESIS"
+	case MEDIAN_DIAERESIS:
+		return "MEDIAN_DIAERESIS"
+	}
+	return ""
+}
+
+// CaesuraPosition records a word break that falls at a named position.
+// Syllable is the index of the syllable after which the break falls.
+type CaesuraPosition struct {
+	Caesura  Caesura
+	Syllable int
+}
+
+type Violation int
+
+const (
+	NO_VIOLATION      Violation = 0
+	PORSONS_BRIDGE    Violation = 1 // a trimeter word ends after a long third anceps
+	MISSING_DIAERESIS Violation = 2 // no word end at the middle of a pentameter
+)
+
+func (e Violation) Name() string {
+	switch e {
+	case PORSONS_BRIDGE:
+		return "PORSONS_BRIDGE"
+	case MISSING_DIAERESIS:
+		return "MISSING_DIAERESIS"
+	}
+	return ""
+}
+
+// ViolationPosition records a metrical rule broken at a syllable.
+type ViolationPosition struct {
+	Violation Violation
+	Syllable  int
+}
+
+// Scansion is one possible metrical reading of a line.
+type Scansion struct {
+	Syllables  []string // syllables after resyllabification across words
+	Quantities []Length // LONG or SHORT for each syllable
+	Feet       []Foot
+	Caesurae   []CaesuraPosition
+	// Anceps lists the syllables that fill an anceps position.
+	Anceps []int
+	// Resolutions lists the feet in which a long position is resolved
+	// into two shorts.
+	Resolutions []int
+	// Dichrona lists the syllables whose vowel length is unknown and
+	// whose quantity was decided by the metre.
+	Dichrona []int
+	// BrevisInLongo is true if the final position is filled by a short
+	// syllable.
+	BrevisInLongo bool
+	Violations    []ViolationPosition
+
+	footSizes []int // syllables in each foot
+}
+
+// Pattern returns the scansion as a string of – (long) and u (short)
+// with feet separated by |. For a Scansion not made by a scanner the
+// feet are taken to be unresolved.
+func (s Scansion) Pattern() string {
+	var b strings.Builder
+	i := 0
+	for f, foot := range s.Feet {
+		if f > 0 {
+			b.WriteString("|")
+		}
+		size := foot.size()
+		if len(s.footSizes) == len(s.Feet) {
+			size = s.footSizes[f]
+		}
+		for j := 0; j < size && i < len(s.Quantities); j++ {
+			if s.Quantities[i] == LONG {
+				b.WriteString("–")
+			} else {
+				b.WriteString("u")
+			}
+			i++
+		}
+	}
+	return b.String()
+}
+
+// ScansionError explains why a line could not be scanned.
+type ScansionError struct {
+	Line   string
+	Reason string
+}
+
+func (e *ScansionError) Error() string {
+	return fmt.Sprintf("greekaccentuation: cannot scan %q: %s", e.Line, e.Reason)
+}
+
+// metricalSyllable is a syllable of a line of verse together with the
+// facts needed to decide its quantity.
+type metricalSyllable struct {
+	text      string
+	word      int
+	wordFinal bool
+	natural   Length // vowel length from syllableLength
+	// consonants following the nucleus up to the next nucleus, counting
+	// ζ ξ ψ as two.
+	consonants int
+	// the following consonants are a stop and a liquid or nasal within
+	// the same word.
+	mutaCumLiquida bool
+	// the syllable ends a word in a vowel and the next word begins
+	// with a vowel.
+	hiatus bool
+	// the syllable ends in a vowel and the next syllable of the same
+	// word begins with a vowel, so the two may be read as one.
+	synizesis bool
+}
+
+// quantities returns whether the syllable may be read long and whether
+// it may be read short, allowing muta cum liquida to leave a syllable
+// light and epic correption in hiatus.
+func (s metricalSyllable) quantities() (bool, bool) {
+	long, short := false, false
+	for _, options := range []WeightOptions{{}, {MutaCumLiquidaLight: true, Hiatus: true}} {
+		w, _ := s.weight(options)
+		long = long || w != LIGHT
+		short = short || w != HEAVY
+	}
+	return long, short
+}
+
+func hasDiaeresis(s []rune) bool {
+	for _, ch := range s {
+		if diaeresis(ch) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func isConsonant(ch rune) bool {
+	return strings.ContainsRune("βγδζθκλμνξπρσςτφχψ", unicode.ToLower(Base(ch)))
+}
+
+func isDoubleConsonant(ch rune) bool {
+	return strings.ContainsRune("ζξψ", unicode.ToLower(Base(ch)))
+}
+
+func isMute(ch rune) bool {
+	return strings.ContainsRune("πβφτδθκγχ", unicode.ToLower(Base(ch)))
+}
+
+func isLiquid(ch rune) bool {
+	return strings.ContainsRune("λρμν", unicode.ToLower(Base(ch)))
+}
+
+// verseWords splits a line into lower case words, dropping punctuation
+// and elision marks.
+func verseWords(line string) []string {
+	var words []string
+	for _, field := range strings.Fields(norm.NFC.String(line)) {
+		w := strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.Is(unicode.Mn, r) {
+				return unicode.ToLower(r)
+			}
+			return -1
+		}, field)
+		if w != "" {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// metricalSyllables syllabifies each word of a line and works out the
+// consonants between each nucleus and the next, across word boundaries.
+// A single consonant closing a word moves to the start of a following
+// word that begins with a vowel.
+func metricalSyllables(words []string) []metricalSyllable {
+	type part struct {
+		onset, nucleus, coda []rune
+		word                 int
+		syllable             string // the syllable within its own word
+	}
+	consonants := func(s []rune) []rune {
+		var c []rune
+		for _, ch := range s {
+			if isConsonant(ch) {
+				c = append(c, ch)
+			}
+		}
+		return c
+	}
+
+	var parts []part
+	var texts []string
+	pending := ""
+	for w, word := range words {
+		for _, s := range Syllabify(word) {
+			o, n, c := onsetNucleusCoda(s)
+			if n == "" {
+				// No vowel, as in an elided δ᾽, so the consonants
+				// begin the next syllable.
+				pending += s
+				continue
+			}
+			parts = append(parts, part{consonants([]rune(pending + o)), []rune(n), consonants([]rune(c)), w, s})
+			texts = append(texts, pending+s)
+			pending = ""
+		}
+	}
+	if pending != "" && len(parts) > 0 {
+		last := len(parts) - 1
+		parts[last].coda = append(parts[last].coda, consonants([]rune(pending))...)
+		texts[last] += pending
+	}
+
+	result := make([]metricalSyllable, len(parts))
+	for i, p := range parts {
+		ms := metricalSyllable{
+			text:    texts[i],
+			word:    p.word,
+			natural: syllableLength(p.syllable, false),
+		}
+		if ms.natural == UNKNOWN && syllableAccent(texts[i]) == CIRCUMFLEX {
+			// Only a long vowel can carry a circumflex
+			ms.natural = LONG
+		}
+		ms.wordFinal = i+1 == len(parts) || parts[i+1].word != p.word
+		following := append([]rune{}, p.coda...)
+		if i+1 < len(parts) {
+			next := parts[i+1]
+			following = append(following, next.onset...)
+			if len(following) == 2 && isMute(following[0]) && isLiquid(following[1]) {
+				ms.mutaCumLiquida = !ms.wordFinal || len(p.coda) == 0
+			}
+			if len(following) == 0 {
+				if ms.wordFinal {
+					ms.hiatus = true
+				} else {
+					// A diaeresis marks the vowels as pronounced apart.
+					ms.synizesis = !hasDiaeresis(next.nucleus)
+				}
+			}
+		}
+		for _, ch := range following {
+			ms.consonants++
+			if isDoubleConsonant(ch) {
+				ms.consonants++
+			}
+		}
+		result[i] = ms
+	}
+
+	// Resyllabify: a final consonant before a vowel begins the next syllable.
+	for i := 0; i+1 < len(result); i++ {
+		if !result[i].wordFinal || len(parts[i].coda) != 1 || len(parts[i+1].onset) != 0 {
+			continue
+		}
+		t := []rune(result[i].text)
+		c := t[len(t)-1]
+		if c == 'ς' {
+			c = 'σ'
+		}
+		result[i].text = string(t[:len(t)-1])
+		result[i+1].text = string(c) + result[i+1].text
+	}
+	return result
+}
+
+// metricalElement is one position of a metre.
+type metricalElement int
+
+const (
+	longElement   metricalElement = iota // must be long
+	shortElement                         // must be short
+	ancepsElement                        // long or short
+	finalElement                         // the last position of a line, brevis in longo
+)
+
+// footOption is one way of realising a foot.
+type footOption struct {
+	elements []metricalElement
+	// split is the number of elements making up the first position of
+	// the foot. It is two when the first position is resolved.
+	split int
+	// resolution is set when a position is resolved into two shorts.
+	resolution bool
+}
+
+func plainFoot(elements ...metricalElement) footOption {
+	return footOption{elements: elements, split: 1}
+}
+
+// metre describes a verse form as the foot options allowed in each
+// foot of the line.
+type metre struct {
+	name     string
+	feet     [][]footOption
+	annotate func(s *Scansion, m matched)
+}
+
+// matched describes how a scansion was built, for annotating it.
+type matched struct {
+	feet   []footOption
+	starts []int // first syllable of each foot
+	words  []int // word of each syllable
+}
+
+func (m matched) wordFinal(i int) bool {
+	return i+1 >= len(m.words) || m.words[i+1] != m.words[i]
+}
+
+// firstPositionEnd returns the last syllable of the first position of a foot.
+func (m matched) firstPositionEnd(foot int) int {
+	return m.starts[foot] + m.feet[foot].split - 1
+}
+
+func (m metre) syllableRange() (int, int) {
+	min, max := 0, 0
+	for _, options := range m.feet {
+		fmin, fmax := -1, 0
+		for _, o := range options {
+			if fmin < 0 || len(o.elements) < fmin {
+				fmin = len(o.elements)
+			}
+			if len(o.elements) > fmax {
+				fmax = len(o.elements)
+			}
+		}
+		min += fmin
+		max += fmax
+	}
+	return min, max
+}
+
+// matchElement tries to fill a metrical element starting at syllable i,
+// returning each possible following syllable index together with the
+// quantity used. Two syllables may be joined by synizesis to fill a
+// long element when allowSynizesis is set.
+func matchElement(s []metricalSyllable, i int, e metricalElement, allowSynizesis bool) (next []int, quantity []Length) {
+	if i >= len(s) {
+		return nil, nil
+	}
+	long, short := s[i].quantities()
+	switch e {
+	case longElement:
+		if long {
+			next, quantity = append(next, i+1), append(quantity, LONG)
+		}
+	case shortElement:
+		if short {
+			next, quantity = append(next, i+1), append(quantity, SHORT)
+		}
+	case ancepsElement, finalElement:
+		if long {
+			next, quantity = append(next, i+1), append(quantity, LONG)
+		} else {
+			next, quantity = append(next, i+1), append(quantity, SHORT)
+		}
+	}
+	if allowSynizesis && e != shortElement && s[i].synizesis && i+1 < len(s) {
+		next, quantity = append(next, i+2), append(quantity, LONG)
+	}
+	return next, quantity
+}
+
+// scan returns every reading of a line in the metre. Synizesis is only
+// used where the line will not scan without it, and then as few times
+// as possible. If the line cannot be scanned the error is a
+// *ScansionError giving the reason.
+func (m metre) scan(line string) ([]Scansion, error) {
+	words := verseWords(line)
+	if len(words) == 0 {
+		return nil, &ScansionError{line, "line contains no words"}
+	}
+	syllables := metricalSyllables(words)
+	min, max := m.syllableRange()
+	if len(syllables) < min {
+		return nil, &ScansionError{line, fmt.Sprintf("%d syllables is too few for %s", len(syllables), m.name)}
+	}
+
+	synizesis := 0
+	for _, s := range syllables {
+		if s.synizesis {
+			synizesis++
+		}
+	}
+	if len(syllables)-synizesis > max {
+		return nil, &ScansionError{line, fmt.Sprintf("%d syllables is too many for %s", len(syllables), m.name)}
+	}
+
+	var results []Scansion
+	for n := 0; n <= synizesis && len(results) == 0; n++ {
+		results = m.match(syllables, n)
+	}
+	if len(results) == 0 {
+		return nil, &ScansionError{line, fmt.Sprintf("the syllable quantities do not fit %s", m.name)}
+	}
+	return results, nil
+}
+
+// match finds every scansion using synizesis exactly maxSynizesis times.
+func (m metre) match(syllables []metricalSyllable, maxSynizesis int) []Scansion {
+	var results []Scansion
+	seen := map[string]bool{}
+
+	var options []footOption
+	var starts []int
+	var texts []string
+	var quantities []Length
+	var words []int
+	var anceps []int
+	var dichrona []int
+	brevisInLongo := false
+	synizesis := 0
+
+	var fillFoot func(i, f int, elements []metricalElement)
+	var nextFoot func(i, f int)
+
+	nextFoot = func(i, f int) {
+		if f == len(m.feet) {
+			if i != len(syllables) || synizesis != maxSynizesis {
+				return
+			}
+			s := Scansion{
+				Syllables:     append([]string{}, texts...),
+				Quantities:    append([]Length{}, quantities...),
+				Anceps:        append([]int{}, anceps...),
+				Dichrona:      append([]int{}, dichrona...),
+				BrevisInLongo: brevisInLongo,
+			}
+			for k, o := range options {
+				end := len(quantities)
+				if k+1 < len(starts) {
+					end = starts[k+1]
+				}
+				q := append([]Length{}, quantities[starts[k]:end]...)
+				if k == len(options)-1 {
+					q[len(q)-1] = LONG
+				}
+				s.Feet = append(s.Feet, footFromQuantities(q))
+				s.footSizes = append(s.footSizes, end-starts[k])
+				if o.resolution {
+					s.Resolutions = append(s.Resolutions, k)
+				}
+			}
+			key := s.Pattern() + DisplayWord(s.Syllables) + fmt.Sprint(s.Anceps, s.BrevisInLongo)
+			if seen[key] {
+				return
+			}
+			seen[key] = true
+			if m.annotate != nil {
+				m.annotate(&s, matched{
+					feet:   append([]footOption{}, options...),
+					starts: append([]int{}, starts...),
+					words:  append([]int{}, words...),
+				})
+			}
+			results = append(results, s)
+			return
+		}
+		for _, o := range m.feet[f] {
+			options = append(options, o)
+			starts = append(starts, len(texts))
+			fillFoot(i, f, o.elements)
+			options = options[:len(options)-1]
+			starts = starts[:len(starts)-1]
+		}
+	}
+
+	fillFoot = func(i, f int, elements []metricalElement) {
+		if len(elements) == 0 {
+			nextFoot(i, f+1)
+			return
+		}
+		e := elements[0]
+		next, quantity := matchElement(syllables, i, e, synizesis < maxSynizesis)
+		for k, j := range next {
+			text := syllables[i].text
+			merged := j == i+2
+			if merged {
+				text += syllables[i+1].text
+				synizesis++
+			}
+			unit := len(texts)
+			texts = append(texts, text)
+			quantities = append(quantities, quantity[k])
+			words = append(words, syllables[i].word)
+			decided := !merged && syllables[i].natural == UNKNOWN && syllables[i].consonants < 2
+			switch {
+			case e == ancepsElement:
+				anceps = append(anceps, unit)
+			case e == finalElement:
+				brevisInLongo = quantity[k] == SHORT
+			case decided:
+				dichrona = append(dichrona, unit)
+			}
+
+			fillFoot(j, f, elements[1:])
+
+			texts = texts[:unit]
+			quantities = quantities[:unit]
+			words = words[:unit]
+			switch {
+			case e == ancepsElement:
+				anceps = anceps[:len(anceps)-1]
+			case e == finalElement:
+				brevisInLongo = false
+			case decided:
+				dichrona = dichrona[:len(dichrona)-1]
+			}
+			if merged {
+				synizesis--
+			}
+		}
+	}
+
+	nextFoot(0, 0)
+	return results
+}
@@ -0,0 +1,138 @@
+package greekaccentuation
+
+import "testing"
+
+func TestVerseWords(t *testing.T) {
+	if !ArrayEqual(verseWords("οὐλομένην, ἣ μυρί᾽ Ἀχαιοῖς"), []string{"οὐλομένην", "ἣ", "μυρί", "ἀχαιοῖς"}) {
+		t.Fatalf("verseWords() failed. Returned %v", verseWords("οὐλομένην, ἣ μυρί᾽ Ἀχαιοῖς"))
+	}
+}
+
+func TestMetricalSyllables(t *testing.T) {
+	s := metricalSyllables([]string{"ὃς", "μάλα", "πολλὰ"})
+	if len(s) != 5 {
+		t.Fatalf("metricalSyllables() failed. Returned %v", s)
+	}
+	if s[0].consonants != 2 {
+		t.Fatalf("metricalSyllables() failed. ὃς followed by %d consonants", s[0].consonants)
+	}
+	if long, short := s[0].quantities(); !long || short {
+		t.Fatal("metricalSyllables() failed. ὃς before μ should be long by position")
+	}
+	if long, short := s[2].quantities(); !long || !short {
+		t.Fatal("metricalSyllables() failed. λα is of unknown length")
+	}
+
+	s = metricalSyllables([]string{"πολύτροπον"})
+	if !s[1].mutaCumLiquida {
+		t.Fatal("metricalSyllables() failed. τρ is muta cum liquida")
+	}
+
+	s = metricalSyllables([]string{"ἔπος", "ἔφη"})
+	if s[1].text != "πο" || s[2].text != "σἔ" {
+		t.Fatalf("metricalSyllables() failed. Returned %s %s", s[1].text, s[2].text)
+	}
+
+	s = metricalSyllables([]string{"ἄξιος"})
+	if s[0].consonants != 2 {
+		t.Fatal("metricalSyllables() failed. ξ is a double consonant")
+	}
+}
+
+func TestScanHexameter(t *testing.T) {
+	tests := []struct {
+		line      string
+		pattern   string
+		syllables string
+		caesurae  []Caesura
+	}{
+		{
+			"μῆνιν ἄειδε θεὰ Πηληϊάδεω Ἀχιλῆος",
+			"–uu|–uu|––|–uu|–uu|–u",
+			"μῆ.νι.νἄ.ει.δε.θε.ὰ.πη.λη.ϊ.ά.δεω.ἀ.χι.λῆ.ος",
+			[]Caesura{PENTHEMIMERAL},
+		},
+		{
+			"οὐλομένην, ἣ μυρί᾽ Ἀχαιοῖς ἄλγε᾽ ἔθηκε,",
+			"–uu|––|–uu|––|–uu|–u",
+			"οὐ.λο.μέ.νη.νἣ.μυ.ρί.ἀ.χαι.οῖ.σἄλ.γε.ἔ.θη.κε",
+			[]Caesura{TROCHAIC, BUCOLIC_DIAERESIS},
+		},
+		{
+			"ἄνδρα μοι ἔννεπε, μοῦσα, πολύτροπον, ὃς μάλα πολλὰ",
+			"–uu|–uu|–uu|–uu|–uu|––",
+			"ἄν.δρα.μοι.ἔν.νε.πε.μοῦ.σα.πο.λύτ.ρο.πο.νὃς.μά.λα.πολ.λὰ",
+			[]Caesura{TROCHAIC, BUCOLIC_DIAERESIS},
+		},
+	}
+	for _, test := range tests {
+		r, err := ScanHexameter(test.line)
+		if err != nil {
+			t.Fatalf("ScanHexameter() failed: %v", err)
+		}
+		if len(r) != 1 {
+			t.Fatalf("ScanHexameter() failed. Returned %d scansions for %s", len(r), test.line)
+		}
+		if r[0].Pattern() != test.pattern {
+			t.Fatalf("ScanHexameter() failed. Returned %s, expected %s", r[0].Pattern(), test.pattern)
+		}
+		if DisplayWord(r[0].Syllables) != test.syllables {
+			t.Fatalf("ScanHexameter() failed. Returned %s", DisplayWord(r[0].Syllables))
+		}
+		if len(r[0].Caesurae) != len(test.caesurae) {
+			t.Fatalf("ScanHexameter() failed. Returned caesurae %v", r[0].Caesurae)
+		}
+		for i, c := range test.caesurae {
+			if r[0].Caesurae[i].Caesura != c {
+				t.Fatalf("ScanHexameter() failed. Returned caesura %s, expected %s",
+					r[0].Caesurae[i].Caesura.Name(), c.Name())
+			}
+		}
+	}
+}
+
+func TestScanHexameterFailure(t *testing.T) {
+	_, err := ScanHexameter("ἐν ἀρχῇ ἦν ὁ λόγος")
+	if err == nil {
+		t.Fatal("ScanHexameter() failed. Expected an error")
+	}
+	if e, ok := err.(*ScansionError); !ok || e.Reason != "7 syllables is too few for a hexameter" {
+		t.Fatalf("ScanHexameter() failed. Returned %v", err)
+	}
+
+	_, err = ScanHexameter("ἐν ἀρχῇ ἦν ὁ λόγος καὶ ὁ λόγος ἦν πρὸς τὸν θεόν")
+	if err == nil {
+		t.Fatal("ScanHexameter() failed. Expected an error")
+	}
+}
+
+func TestMetreSyllableRange(t *testing.T) {
+	if min, max := hexameter.syllableRange(); min != 12 || max != 17 {
+		t.Fatalf("syllableRange() failed. Returned %d, %d", min, max)
+	}
+	if min, max := iambicTrimeter.syllableRange(); min != 12 || max != 17 {
+		t.Fatalf("syllableRange() failed. Returned %d, %d", min, max)
+	}
+}
+
+func TestFootFromQuantities(t *testing.T) {
+	if footFromQuantities([]Length{LONG, SHORT, SHORT}) != DACTYL {
+		t.Fatal("footFromQuantities() failed")
+	}
+	if footFromQuantities([]Length{SHORT, SHORT, LONG}) != ANAPAEST {
+		t.Fatal("footFromQuantities() failed")
+	}
+	if footFromQuantities([]Length{SHORT, LONG, SHORT}) != NO_FOOT {
+		t.Fatal("footFromQuantities() failed")
+	}
+}
+
+func TestScansionPatternWithoutFootSizes(t *testing.T) {
+	s := Scansion{
+		Quantities: []Length{LONG, SHORT, SHORT, LONG, LONG, LONG},
+		Feet:       []Foot{DACTYL, SPONDEE, LONGUM},
+	}
+	if got := s.Pattern(); got != "–uu|––|–" {
+		t.Fatalf("Pattern() failed. Returned %s", got)
+	}
+}
@@ -0,0 +1,113 @@
+package greekaccentuation
+
+import (
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// isAdscript reports whether the iota at r[i] of a decomposed word is an
+// iota adscript, written beside a long α, η or ω rather than under it.
+// The iota must carry no mark of its own. After α it is an adscript only
+// if the α carries a mark, such as the accent of ᾆι or the macron of
+// ᾱι, since a diphthong αι takes its marks on the iota. After η and ω it
+// is an adscript if the vowel carries a mark (τῶι, ἧι), if the iota ends
+// the word (τωι), or if known is true because the word is known to use
+// adscripts; otherwise the two vowels are read apart (η.ι).
+func isAdscript(r []rune, i int, known bool) bool {
+	if i <= 0 || i >= len(r) || unicode.ToLower(r[i]) != 'ι' {
+		return false
+	}
+	if i+1 < len(r) && isCombiningMark(r[i+1]) {
+		return false
+	}
+	j := i - 1
+	for j >= 0 && isCombiningMark(r[j]) {
+		j--
+	}
+	if j < 0 {
+		return false
+	}
+	for _, m := range r[j+1 : i] {
+		if m == IOTA.Rune() {
+			return false
+		}
+	}
+	marked := j < i-1
+	final := i+1 == len(r) || !unicode.IsLetter(r[i+1])
+	switch unicode.ToLower(r[j]) {
+	case 'η', 'ω':
+		return marked || final || known
+	case 'α':
+		return marked
+	}
+	return false
+}
+
+// adscripts marks each rune of a decomposed word that is an iota
+// adscript. A word with one adscript is known to use them, so every ι
+// after an η or ω in it is read as an adscript (ωιδηι is ᾠδῇ).
+func adscripts(r []rune) []bool {
+	a := make([]bool, len(r))
+	known := false
+	for i := range r {
+		a[i] = isAdscript(r, i, false)
+		known = known || a[i]
+	}
+	if known {
+		for i := range r {
+			a[i] = isAdscript(r, i, true)
+		}
+	}
+	return a
+}
+
+// ToSubscript writes each iota adscript of text as an iota subscript
+// (τῶι → τῷ, ΤΩΙ → Τῼ, ᾍιδης → ᾍδης) keeping the accents and breathings
+// of the vowel. An iota that may be the second vowel of the diphthong αι
+// is left alone.
+func ToSubscript(text string) string {
+	r := []rune(norm.NFD.String(text))
+	a := adscripts(r)
+	result := make([]rune, 0, len(r))
+	for i, ch := range r {
+		if a[i] {
+			result = append(result, IOTA.Rune())
+		} else {
+			result = append(result, ch)
+		}
+	}
+	return norm.NFC.String(string(result))
+}
+
+// ToAdscript writes each iota subscript of text as an iota adscript
+// after its vowel (τῷ → τῶι). The iota of a capital with a subscript,
+// such as ᾼ ῌ ῼ, is a capital Ι beside other capitals and a small ι
+// otherwise (ᾍδης → Ἅιδης).
+func ToAdscript(text string) string {
+	r := []rune(norm.NFD.String(text))
+	isUpper := func(i int) bool {
+		return i >= 0 && i < len(r) && unicode.IsUpper(r[i])
+	}
+	result := make([]rune, 0, len(r)+1)
+	for i, ch := range r {
+		if ch != IOTA.Rune() {
+			result = append(result, ch)
+			continue
+		}
+		j := i - 1
+		for j >= 0 && isCombiningMark(r[j]) {
+			j--
+		}
+		next := i + 1
+		for next < len(r) && isCombiningMark(r[next]) {
+			next++
+		}
+		if isUpper(j) && (isUpper(next) || isUpper(j-1)) {
+			result = append(result, 'Ι')
+		} else {
+			result = append(result, 'ι')
+		}
+	}
+	return norm.NFC.String(string(result))
+}
@@ -0,0 +1,114 @@
+package greekaccentuation
+
+import (
+	"testing"
+)
+
+func TestToSubscript(t *testing.T) {
+	tests := map[string]string{
+		"τῶι":         "τῷ",
+		"τῆι":         "τῇ",
+		"ΤΩΙ":         "Τῼ",
+		"ᾍιδης":       "ᾍιδης",
+		"Ἅιδης":       "ᾍδης",
+		"ᾠιδή":        "ᾠιδή",
+		"ὠιδή":        "ᾠδή",
+		"ἆισαι":       "ᾆσαι",
+		"καί":         "καί",
+		"παῖς":        "παῖς",
+		"αἰεί":        "αἰεί",
+		"ἠΐθεος":      "ἠΐθεος",
+		"χώραι":       "χώραι",
+		"τὴν ὁδὸν":    "τὴν ὁδὸν",
+		"ἐν τῆι ὁδῶι": "ἐν τῇ ὁδῷ",
+	}
+	for text, expected := range tests {
+		if got := ToSubscript(text); got != expected {
+			t.Errorf("ToSubscript(%q) = %q, expected %q", text, got, expected)
+		}
+	}
+}
+
+func TestToAdscript(t *testing.T) {
+	tests := map[string]string{
+		"τῷ":        "τῶι",
+		"ᾠδή":       "ὠιδή",
+		"ᾍδης":      "Ἅιδης",
+		"ΤΩΙ":       "ΤΩΙ",
+		"ΤῼΝ":      "ΤΩΙΝ",
+		"ᾌδω":       "Ἄιδω",
+		"ἐν τῇ ὁδῷ": "ἐν τῆι ὁδῶι",
+		"καί":       "καί",
+	}
+	for text, expected := range tests {
+		if got := ToAdscript(text); got != expected {
+			t.Errorf("ToAdscript(%q) = %q, expected %q", text, got, expected)
+		}
+		// An unmarked ΩΙ inside a word is not known to be an adscript
+		if ToAdscript(text) == "ΤΩΙΝ" {
+			continue
+		}
+		if got := ToSubscript(ToAdscript(text)); got != ToSubscript(text) {
+			t.Errorf("ToSubscript(ToAdscript(%q)) = %q", text, got)
+		}
+	}
+}
+
+func TestAdscriptSyllables(t *testing.T) {
+	tests := map[string]string{
+		"τῶι":   "τῶι",
+		"ὁδῶι":  "ὁ.δῶι",
+		"ὠιδή":  "ὠι.δή",
+		"χώραι": "χώ.ραι",
+		"ἆισαι": "ἆι.σαι",
+		"τωι":   "τωι",
+		// a word with one adscript uses them throughout
+		"ωιδηι": "ωι.δηι",
+		// otherwise an unmarked ηι or ωι inside a word is two vowels
+		"αεηιουω": "α.ε.η.ι.ου.ω",
+		"ωιδη":    "ω.ι.δη",
+	}
+	for word, expected := range tests {
+		if got := DisplayWord(Syllabify(word)); got != expected {
+			t.Errorf("Syllabify(%q) = %q, expected %q", word, got, expected)
+		}
+	}
+	if syllableLength("ραι", true) != SHORT {
+		t.Errorf("syllableLength() of a final diphthong failed")
+	}
+	if syllableLength("ἆι", true) != LONG || syllableLength("δῶι", true) != LONG {
+		t.Errorf("syllableLength() of an adscript failed")
+	}
+	if syllableLength("δῶι", true) != syllableLength("δῷ", true) {
+		t.Errorf("syllableLength() differs for adscript and subscript")
+	}
+}
+
+func TestAccentAdscript(t *testing.T) {
+	// The accent goes on the vowel before the adscript, where it stands
+	// on the vowel with a subscript (θεῴ, θεώι)
+	tests := []struct {
+		word, lemma, expected string
+	}{
+		{"τωι", "τῷ", "τῶι"},
+		{"θεωι", "θεός", "θεώι"},
+		{"ωιδηι", "ᾠδή", "ωιδήι"},
+		{"ἀγορᾱι", "ἀγορά", "ἀγορᾱ́ι"},
+		{"χωραι", "χώρα", "χώραι"},
+	}
+	for _, test := range tests {
+		if got := Persistent(test.word, test.lemma, false); got != test.expected {
+			t.Errorf("Persistent(%q, %q) = %q, expected %q", test.word, test.lemma, got, test.expected)
+		}
+		subscript := Persistent(ToSubscript(test.word), test.lemma, false)
+		if got := Persistent(test.word, test.lemma, false); ToSubscript(got) != subscript {
+			t.Errorf("Persistent(%q, %q) = %q, but %q with a subscript", test.word, test.lemma, got, subscript)
+		}
+	}
+	if got := Recessive("ἀνθρωπωι", true, false); got != "ἀνθρώπωι" {
+		t.Errorf("Recessive(%q) = %q", "ἀνθρωπωι", got)
+	}
+	if got := Recessive("ὡι", true, false); got != "ὧι" {
+		t.Errorf("Recessive(%q) = %q", "ὡι", got)
+	}
+}
@@ -0,0 +1,109 @@
+package greekaccentuation
+
+import "unicode"
+
+// COMPOUND_BOUNDARY marks the join of a compound in a word given to
+// Syllabify (συν|άγω). A syllable always ends at the mark, whatever the
+// consonants around it, and the mark is left out of the syllables.
+const COMPOUND_BOUNDARY = '|'
+
+// SyllabificationPolicy decides which consonants between two vowels
+// begin the second syllable. The rest end the first.
+type SyllabificationPolicy interface {
+	// Onset reports whether a cluster of consonants, given as lower case
+	// letters without diacritics, can begin a syllable. A single
+	// consonant is asked about as well.
+	Onset(cluster []rune) bool
+}
+
+// ClusterPolicy is a policy given by a list of consonant pairs. A cluster
+// begins a syllable if each consonant in it forms a listed pair with the
+// one after it, so στρ is allowed by στ and τρ. A single consonant in the
+// list may stand before any consonant.
+type ClusterPolicy []string
+
+func (p ClusterPolicy) Onset(cluster []rune) bool {
+	for i := 0; i+1 < len(cluster); i++ {
+		if !p.has(string(cluster[i : i+2])) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p ClusterPolicy) has(pair string) bool {
+	for _, c := range p {
+		if c == pair || c == pair[:len(string([]rune(pair)[0]))] {
+			return true
+		}
+	}
+	return false
+}
+
+// TRADITIONAL is the policy of Syllabify. It keeps together the clusters
+// listed here and φ before any consonant, but divides τρ and χθ
+// (πο.λύτ.ρο.πον, ἐχ.θρός), and so στρ, which it does not allow as a
+// whole onset though it allows στ (ἄστ.ρον, as Syllabify has always
+// divided it); use SyllabifyWith and SMYTH to follow Smyth.
+var TRADITIONAL = ClusterPolicy{
+	"βδ", "βλ", "βρ",
+	"γλ", "γν", "γρ",
+	"δρ",
+	"θλ", "θν", "θρ",
+	"κλ", "κν", "κρ", "κτ",
+	"μν",
+	"πλ", "πν", "πρ", "πτ",
+	"σβ", "σθ", "σκ", "σμ", "σπ", "στ", "σφ", "σχ",
+	"φθ", "φλ", "φ",
+	"χλ", "χρ",
+}
+
+// SMYTH follows Smyth §140: a consonant, or a group of consonants that
+// can begin a word, goes with the vowel after it (πα.τρός, ἐ.χθρός,
+// ἄ.στρον), and a double consonant is divided (θά.λασ.σα).
+var SMYTH = ClusterPolicy{
+	"βδ", "βλ", "βρ",
+	"γλ", "γν", "γρ",
+	"δμ", "δρ",
+	"θλ", "θν", "θρ",
+	"κλ", "κμ", "κν", "κρ", "κτ",
+	"μν",
+	"πλ", "πν", "πρ", "πτ",
+	"σβ", "σθ", "σκ", "σμ", "σπ", "στ", "σφ", "σχ",
+	"τλ", "τμ", "τρ",
+	"φθ", "φλ", "φν", "φρ",
+	"χθ", "χλ", "χν", "χρ",
+}
+
+// MODERN is the rule taught in Modern Greek schools, which also keeps
+// together the clusters that begin Modern Greek words, such as μπ, ντ,
+// γκ and τσ (ά.μπω.τις).
+var MODERN = append(append(ClusterPolicy{}, SMYTH...),
+	"γδ", "γκ", "μπ", "ντ", "τζ", "τσ",
+)
+
+type strictPolicy struct{}
+
+func (strictPolicy) Onset(cluster []rune) bool {
+	return len(cluster) <= 1
+}
+
+// STRICT splits every cluster before its last consonant (VC.CV), so only
+// a single consonant begins a syllable (πατ.ρός, ἐχ.θρός).
+var STRICT SyllabificationPolicy = strictPolicy{}
+
+// onsetCluster returns a consonant followed by the consonants that begin
+// a decomposed syllable, as lower case letters without diacritics.
+func onsetCluster(ch rune, syllable []rune) []rune {
+	cluster := []rune{unicode.ToLower(ch)}
+	for _, c := range syllable {
+		if isCombiningMark(c) {
+			continue
+		}
+		if IsVowel(c) {
+			break
+		}
+		cluster = append(cluster, unicode.ToLower(c))
+	}
+	return cluster
+}
@@ -18,6 +18,27 @@ func IsVowel(ch rune) bool {
 	}
 }
 
+// isCombiningMark returns true for a decomposed diacritic such as an
+// accent, breathing, diaeresis or iota subscript.
+func isCombiningMark(ch rune) bool {
+	return unicode.Is(unicode.Mn, ch)
+}
+
+// diaeresisFollows reports whether the first vowel of a decomposed
+// syllable has a diaeresis, which shows that it does not form a
+// diphthong with the vowel before it.
+func diaeresisFollows(syllable []rune) bool {
+	for _, ch := range syllable[1:] {
+		if !isCombiningMark(ch) {
+			return false
+		}
+		if ch == DIAERESIS.Rune() {
+			return true
+		}
+	}
+	return false
+}
+
 // IsDipthong tests if a rune string is a valid dipthong
 func isDipthong(a, b rune) bool {
 	a = unicode.ToLower(a)
@@ -34,34 +55,35 @@ func isDipthong(a, b rune) bool {
 	})
 }
 
-// isValidConsonantCluster returns true if this consonant
-// combination would be considered valid.
-func isValidConsonantCluster(ch rune, syllable []rune) bool {
-	candidate := append([]rune{ch}, syllable...)
-	return runesHavePrefix(candidate, [][]rune{
-		[]rune("βδ"), []rune("βλ"), []rune("βρ"),
-		[]rune("γλ"), []rune("γν"), []rune("γρ"),
-		[]rune("δρ"),
-		[]rune("θλ"), []rune("θν"), []rune("θρ"),
-		[]rune("κλ"), []rune("κν"), []rune("κρ"), []rune("κτ"),
-		[]rune("μν"),
-		[]rune("πλ"), []rune("πν"), []rune("πρ"), []rune("πτ"),
-		[]rune("σβ"), []rune("σθ"), []rune("σκ"), []rune("σμ"),
-		[]rune("σπ"), []rune("στ"), []rune("σφ"), []rune("σχ"), []rune("στρ"),
-		[]rune("φθ"), []rune("φλ"), []rune("φ"),
-		[]rune("χλ"), []rune("χρ"),
-	})
-}
-
 // DisplayWord is a helper function that displays a syllable
 // array as a string.
 func DisplayWord(parts []string) string {
 	return strings.Join(parts, ".")
 }
 
-// Syllabify splits a word into a string array of syllables.
+// Syllabify splits a word into a string array of syllables, dividing
+// consonant clusters by the TRADITIONAL policy. Every combining mark,
+// whether accent, breathing, diaeresis, iota subscript or length mark,
+// stays with the letter it is on (λῆ, ὁ.δὸς), and consonants at the
+// start of a word that cannot begin a syllable together are kept in the
+// first syllable (τροί.ης, χθών) rather than left as a syllable of their
+// own, where they would take the accent (χ́θονες for χθόνες).
 func Syllabify(word string) []string {
+	return syllabify(word, TRADITIONAL, false)
+}
+
+// SyllabifyWith splits a word into syllables, dividing consonant clusters
+// by the policy given. A COMPOUND_BOUNDARY in the word overrides the
+// policy (συν|άγω → συν.ά.γω).
+func SyllabifyWith(word string, policy SyllabificationPolicy) []string {
+	return syllabify(word, policy, true)
+}
+
+// syllabify splits a word into syllables by a policy, breaking at each
+// COMPOUND_BOUNDARY if boundaries is true.
+func syllabify(word string, policy SyllabificationPolicy, boundaries bool) []string {
 	characters := []rune(norm.NFD.String(word))
+	adscript := adscripts(characters)
 	state := 0
 	currentSyllable := []rune{}
 	result := []string{}
@@ -69,6 +91,14 @@ func Syllabify(word string) []string {
 	// at the appropriate positions.
 	for i := len(characters) - 1; i >= 0; i-- {
 		ch := characters[i]
+		if boundaries && ch == COMPOUND_BOUNDARY {
+			if state != 0 {
+				result = append([]string{string(currentSyllable)}, result...)
+				currentSyllable = []rune{}
+				state = 0
+			}
+			continue
+		}
 		//fmt.Println(i, string(ch), state, "isvowel", IsVowel(ch), "--", word, " <-->", string(currentSyllable), result)
 		switch state {
 		case 0:
@@ -80,14 +110,12 @@ func Syllabify(word string) []string {
 		case 1:
 			// We have eaten a vowel, now just take in legitimate vowel combinations
 			// or the consonante that appears at the start of the syllable. ἴαμα
-			if IsVowel(ch) || ch == ROUGH.Rune() || ch == ACUTE.Rune() || ch == SMOOTH.Rune() {
-				if currentSyllable[0] == ACUTE.Rune() {
-					currentSyllable = append([]rune{ch}, currentSyllable...)
-				} else if currentSyllable[0] == ROUGH.Rune() {
-					currentSyllable = append([]rune{ch}, currentSyllable...)
-				} else if currentSyllable[0] == SMOOTH.Rune() {
+			if IsVowel(ch) || isCombiningMark(ch) {
+				if isCombiningMark(currentSyllable[0]) || adscript[i+1] {
+					// A diacritic or an iota adscript belongs to the
+					// vowel before it
 					currentSyllable = append([]rune{ch}, currentSyllable...)
-				} else if isDipthong(ch, currentSyllable[0]) {
+				} else if isDipthong(ch, currentSyllable[0]) && !diaeresisFollows(currentSyllable) {
 					if len(currentSyllable) > 1 && (currentSyllable[1] == 'ι' || currentSyllable[1] == 'Ι') {
 						result = append([]string{string(currentSyllable[1:])}, result...)
 						currentSyllable = append([]rune{ch}, currentSyllable[0])
@@ -105,12 +133,12 @@ func Syllabify(word string) []string {
 		case 2:
 			// We have eaten a full syllable, but we might need to eat a
 			// preceeding consonant.
-			if IsVowel(ch) || ch == ACUTE.Rune() {
+			if IsVowel(ch) || isCombiningMark(ch) {
 				result = append([]string{string(currentSyllable)}, result...)
 				currentSyllable = []rune{ch}
 				state = 1
 			} else {
-				if isValidConsonantCluster(ch, currentSyllable) {
+				if policy.Onset(onsetCluster(ch, currentSyllable)) {
 					currentSyllable = append([]rune{ch}, currentSyllable...)
 				} else {
 					result = append([]string{string(currentSyllable)}, result...)
@@ -120,7 +148,13 @@ func Syllabify(word string) []string {
 			}
 		}
 	}
-	result = append([]string{string(currentSyllable)}, result...)
+	if state == 0 && len(result) > 0 {
+		// Leading consonants that did not form a valid cluster
+		// still belong to the first syllable.
+		result[0] = string(currentSyllable) + result[0]
+	} else {
+		result = append([]string{string(currentSyllable)}, result...)
+	}
 	for i, _ := range result {
 		result[i] = norm.NFC.String(result[i])
 	}
@@ -249,6 +283,15 @@ func syllableLength(s string, finalPosition ...bool) Length {
 		return UNKNOWN
 	}
 
+	// The vowel of a crasis is contracted, and so long
+	if hasKoronis([]rune(onset(s)), n) {
+		return LONG
+	}
+	// As with a subscript, a vowel with an iota adscript is long
+	if d := []rune(norm.NFD.String(string(n))); isAdscript(d, len(d)-1, false) {
+		return LONG
+	}
+
 	r := rime(s) // Middle and last part of syllable
 
 	if len(n) > 1 {
@@ -351,3 +394,74 @@ func addNecessaryBreathing(w string, breathing Breathing) string {
 		return w
 	}
 }
+
+// SyllableSpan is a syllable of a word with its place in the string the
+// caller gave, as byte offsets for slicing and rune offsets for display.
+// Text is the caller's own slice word[Start:End], in whatever
+// normalization form it was written.
+type SyllableSpan struct {
+	Text      string
+	Start     int
+	End       int
+	RuneStart int
+	RuneEnd   int
+}
+
+// SyllabifySpans splits a word into syllables as Syllabify does and
+// returns where each one lies in the word. The word may be in NFC, NFD or
+// a mixture of the two.
+func SyllabifySpans(word string) []SyllableSpan {
+	return syllableSpans(word, Syllabify(word), false)
+}
+
+// SyllabifySpansWith is SyllabifySpans with a syllabification policy. A
+// COMPOUND_BOUNDARY lies between two spans and is part of neither.
+func SyllabifySpansWith(word string, policy SyllabificationPolicy) []SyllableSpan {
+	return syllableSpans(word, SyllabifyWith(word, policy), true)
+}
+
+// syllableSpans finds the syllables of a word in it, skipping each
+// COMPOUND_BOUNDARY between them if boundaries is true.
+func syllableSpans(word string, syllables []string, boundaries bool) []SyllableSpan {
+	// A syllable always begins with a letter rather than a combining
+	// mark, so the syllables are matched to the word by counting letters,
+	// which does not depend on how the word was normalized.
+	var offsets []int
+	var runes []rune
+	for i, ch := range word {
+		offsets = append(offsets, i)
+		runes = append(runes, ch)
+	}
+	offsets = append(offsets, len(word))
+
+	var result []SyllableSpan
+	pos := 0
+	for _, s := range syllables {
+		letters := 0
+		for _, ch := range norm.NFD.String(s) {
+			if !isCombiningMark(ch) {
+				letters++
+			}
+		}
+		for boundaries && pos < len(runes) && runes[pos] == COMPOUND_BOUNDARY {
+			pos++
+		}
+		start, n := pos, 0
+		for ; pos < len(runes); pos++ {
+			if !isCombiningMark(runes[pos]) {
+				if n == letters {
+					break
+				}
+				n++
+			}
+		}
+		result = append(result, SyllableSpan{
+			Text:      word[offsets[start]:offsets[pos]],
+			Start:     offsets[start],
+			End:       offsets[pos],
+			RuneStart: start,
+			RuneEnd:   pos,
+		})
+	}
+	return result
+}
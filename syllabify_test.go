@@ -3,6 +3,8 @@ package greekaccentuation
 import (
 	"fmt"
 	"testing"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 func TestIsVowel(t *testing.T) {
@@ -62,21 +64,71 @@ func TestIsDipthong(t *testing.T) {
 	}
 }
 
-func TestIsValidConsonantCluster(t *testing.T) {
-	if !isValidConsonantCluster('π', []rune("ν")) {
-		t.Fatal("isValidConsonantCluster() failed")
-	}
-	if !isValidConsonantCluster('π', []rune("νε")) {
-		t.Fatal("isValidConsonantCluster() failed")
-	}
-	if isValidConsonantCluster('σ', []rune("ν")) {
-		t.Fatal("isValidConsonantCluster() failed")
+func TestClusterPolicyOnset(t *testing.T) {
+	tests := []struct {
+		policy   SyllabificationPolicy
+		cluster  string
+		expected bool
+	}{
+		{TRADITIONAL, "πν", true},
+		{TRADITIONAL, "σν", false},
+		{TRADITIONAL, "στ", true},
+		// every pair in a cluster must be allowed, and τρ is not
+		{TRADITIONAL, "στρ", false},
+		{TRADITIONAL, "τρ", false},
+		// φ may stand before any consonant
+		{TRADITIONAL, "φτ", true},
+		{SMYTH, "στρ", true},
+		{SMYTH, "σν", false},
+		{MODERN, "στρ", true},
+		{MODERN, "μπ", true},
+		{STRICT, "στ", false},
+		{STRICT, "σ", true},
+	}
+	for _, test := range tests {
+		if got := test.policy.Onset([]rune(test.cluster)); got != test.expected {
+			t.Errorf("%T Onset(%q) = %v, expected %v", test.policy, test.cluster, got, test.expected)
+		}
+	}
+	if got := onsetCluster('Π', []rune("ρα")); string(got) != "πρ" {
+		t.Errorf("onsetCluster() = %q", string(got))
 	}
-	if !isValidConsonantCluster('σ', []rune("τρ")) {
-		t.Fatal("isValidConsonantCluster() failed")
+}
+
+func TestSyllabifyWith(t *testing.T) {
+	tests := []struct {
+		word     string
+		policy   SyllabificationPolicy
+		expected string
+	}{
+		{"πατρός", TRADITIONAL, "πατ.ρός"},
+		{"ἐχθρός", TRADITIONAL, "ἐχ.θρός"},
+		{"ἄστρον", TRADITIONAL, "ἄστ.ρον"},
+		{"πατρός", SMYTH, "πα.τρός"},
+		{"ἐχθρός", SMYTH, "ἐ.χθρός"},
+		{"ἄστρον", SMYTH, "ἄ.στρον"},
+		{"ἄμπωτις", SMYTH, "ἄμ.πω.τις"},
+		{"θάλασσα", SMYTH, "θά.λασ.σα"},
+		{"ἄμπωτις", MODERN, "ἄ.μπω.τις"},
+		{"ἄστρον", MODERN, "ἄ.στρον"},
+		{"πατρός", STRICT, "πατ.ρός"},
+		{"ἄστρον", STRICT, "ἄστ.ρον"},
+		{"ἄνθρωπος", STRICT, "ἄνθ.ρω.πος"},
+		{"συν|άγω", SMYTH, "συν.ά.γω"},
+		{"συναγω", SMYTH, "συ.να.γω"},
+		{"ἐκ|βαίνω", SMYTH, "ἐκ.βαί.νω"},
+		{"προ|ΐστημι", SMYTH, "προ.ΐ.στη.μι"},
+		{"συν|άγω", STRICT, "συν.ά.γω"},
+	}
+	for _, test := range tests {
+		if got := DisplayWord(SyllabifyWith(test.word, test.policy)); got != test.expected {
+			t.Errorf("SyllabifyWith(%q) = %q, expected %q", test.word, got, test.expected)
+		}
 	}
-	if !isValidConsonantCluster('σ', []rune("τρα")) {
-		t.Fatal("isValidConsonantCluster() failed")
+
+	// Syllabify keeps its traditional division
+	if got := DisplayWord(Syllabify("πολύτροπον")); got != "πο.λύτ.ρο.πον" {
+		t.Errorf("Syllabify(%q) = %q", "πολύτροπον", got)
 	}
 }
 
@@ -144,12 +196,85 @@ func TestSyllabify(t *testing.T) {
 	if !ArrayEqual(Syllabify("Ἰαρέδ"), []string{"Ἰ", "α", "ρέδ"}) {
 		t.Fatalf("Syllabify() failed. Returned %v", Syllabify("Ἰαρέδ"))
 	}
+	if !ArrayEqual(Syllabify("ἀχιλῆος"), []string{"ἀ", "χι", "λῆ", "ος"}) {
+		t.Fatalf("Syllabify() failed. Returned %v", Syllabify("ἀχιλῆος"))
+	}
+	if !ArrayEqual(Syllabify("τροίης"), []string{"τροί", "ης"}) {
+		t.Fatalf("Syllabify() failed. Returned %v", Syllabify("τροίης"))
+	}
+	// A diaeresis breaks a diphthong
+	if !ArrayEqual(Syllabify("προΐστημι"), []string{"προ", "ΐ", "στη", "μι"}) {
+		t.Fatalf("Syllabify() failed. Returned %v", Syllabify("προΐστημι"))
+	}
+	if !ArrayEqual(Syllabify("ἀΐδιος"), []string{"ἀ", "ΐ", "δι", "ος"}) {
+		t.Fatalf("Syllabify() failed. Returned %v", Syllabify("ἀΐδιος"))
+	}
+	if !ArrayEqual(Syllabify("Μωϋσῆς"), []string{"Μω", "ϋ", "σῆς"}) {
+		t.Fatalf("Syllabify() failed. Returned %v", Syllabify("Μωϋσῆς"))
+	}
+	if !ArrayEqual(Syllabify("πραΰς"), []string{"πρα", "ΰς"}) {
+		t.Fatalf("Syllabify() failed. Returned %v", Syllabify("πραΰς"))
+	}
 	// TODO: I am not yet sure of the form of ῡ́ and why it is relevant.
 	//if !ArrayEqual(Syllabify("φῡ́ω"), []string{"φῡ́", "ω"}) {
 	//	t.Fatalf("Syllabify() failed: %v", Syllabify("φῡ́ω"))
 	//}
 }
 
+func TestSyllabifyCombiningMarks(t *testing.T) {
+	tests := []struct {
+		word     string
+		expected string
+	}{
+		// every combining mark stays with its letter
+		{"ἀχιλῆος", "ἀ.χι.λῆ.ος"},
+		{"ὁδὸς", "ὁ.δὸς"},
+		{"ψυχῇ", "ψυ.χῇ"},
+		{"ἀΐδιος", "ἀ.ΐ.δι.ος"},
+		{"μᾱ́τηρ", "μᾱ́.τηρ"},
+		{norm.NFD.String("ἀχιλῆος"), "ἀ.χι.λῆ.ος"},
+		// leading consonants belong to the first syllable
+		{"τροίης", "τροί.ης"},
+		{"χθών", "χθών"},
+		{"χθονος", "χθο.νος"},
+		{"στρατός", "στρα.τός"},
+		{"στρατου", "στρα.του"},
+		{"τλήμων", "τλή.μων"},
+		{"σκῆπτρον", "σκῆπτ.ρον"},
+	}
+	for _, test := range tests {
+		if got := DisplayWord(Syllabify(test.word)); got != test.expected {
+			t.Errorf("Syllabify(%q) = %q, expected %q", test.word, got, test.expected)
+		}
+	}
+}
+
+func TestLeadingConsonantsAccent(t *testing.T) {
+	// leading consonants are not a syllable that can take the accent
+	tests := []struct {
+		word, expected string
+	}{
+		{"χθονες", "χθόνες"},
+		{"χθων", "χθῶν"},
+		{"στρατηγος", "στράτηγος"},
+		{"πτολεμος", "πτόλεμος"},
+	}
+	for _, test := range tests {
+		if got := Recessive(test.word, true, false); got != test.expected {
+			t.Errorf("Recessive(%q) = %q, expected %q", test.word, got, test.expected)
+		}
+	}
+	if got := Persistent("χθονος", "χθών", false); got != "χθόνος" {
+		t.Errorf("Persistent(%q, %q) = %q", "χθονος", "χθών", got)
+	}
+	if got := Persistent("στρατου", "στρατός", false); got != "στρατού" {
+		t.Errorf("Persistent(%q, %q) = %q", "στρατου", "στρατός", got)
+	}
+	if got := Persistent("ἀχιληος", "ἀχιλῆος", false); got != "ἀχιλῆος" {
+		t.Errorf("Persistent(%q, %q) = %q", "ἀχιληος", "ἀχιλῆος", got)
+	}
+}
+
 func TestSyllableAccent(t *testing.T) {
 	if syllableAccent("") != NO_ACCENT {
 		t.Fatal("syllableAccent() failed")
@@ -409,3 +534,46 @@ func ArrayEqual(a, b []string) bool {
 	}
 	return true
 }
+
+func TestSyllabifySpans(t *testing.T) {
+	nfc := "ἄνθρωπος"
+	nfd := norm.NFD.String(nfc)
+	// ἄ decomposed and ώ composed
+	mixed := norm.NFD.String("ἄ") + "νθρ" + norm.NFC.String("ώ") + "πος"
+	for _, word := range []string{nfc, nfd, mixed} {
+		spans := SyllabifySpans(word)
+		var parts []string
+		for i, s := range spans {
+			if s.Text != word[s.Start:s.End] {
+				t.Errorf("SyllabifySpans(%q)[%d].Text = %q, expected %q", word, i, s.Text, word[s.Start:s.End])
+			}
+			if s.Text != string([]rune(word)[s.RuneStart:s.RuneEnd]) {
+				t.Errorf("SyllabifySpans(%q)[%d] has rune offsets %d:%d", word, i, s.RuneStart, s.RuneEnd)
+			}
+			if i > 0 && s.Start != spans[i-1].End {
+				t.Errorf("SyllabifySpans(%q)[%d] does not follow the span before it", word, i)
+			}
+			parts = append(parts, norm.NFC.String(s.Text))
+		}
+		if len(spans) == 0 || spans[0].Start != 0 || spans[len(spans)-1].End != len(word) {
+			t.Errorf("SyllabifySpans(%q) does not cover the word: %v", word, spans)
+		}
+		if !ArrayEqual(parts, Syllabify(word)) {
+			t.Errorf("SyllabifySpans(%q) = %v, expected %v", word, parts, Syllabify(word))
+		}
+	}
+
+	spans := SyllabifySpans(nfd)
+	if spans[0].Start != 0 || spans[0].End != 8 || spans[0].RuneEnd != 4 {
+		t.Errorf("SyllabifySpans(%q)[0] = %+v", nfd, spans[0])
+	}
+	spans = SyllabifySpans(nfc)
+	if spans[1].Text != "θρω" || spans[1].RuneStart != 2 || spans[1].RuneEnd != 5 {
+		t.Errorf("SyllabifySpans(%q)[1] = %+v", nfc, spans[1])
+	}
+
+	spans = SyllabifySpansWith("συν|άγω", SMYTH)
+	if len(spans) != 3 || spans[0].Text != "συν" || spans[1].Text != "ά" || spans[1].RuneStart != 4 {
+		t.Errorf("SyllabifySpansWith(%q) = %+v", "συν|άγω", spans)
+	}
+}
@@ -0,0 +1,800 @@
+// Code generated by gen_tables.go; DO NOT EDIT.
+
+package greekaccentuation
+
+const greekFirst = 0x0370
+const greekLast = 0x03FF
+
+var greekDecompositions = [...]decomposition{
+	{0x0370, nil},                    // U+0370
+	{0x0371, nil},                    // U+0371
+	{0x0372, nil},                    // U+0372
+	{0x0373, nil},                    // U+0373
+	{0x02B9, nil},                    // U+0374
+	{0x0375, nil},                    // U+0375
+	{0x0376, nil},                    // U+0376
+	{0x0377, nil},                    // U+0377
+	{0x0378, nil},                    // U+0378
+	{0x0379, nil},                    // U+0379
+	{0x037A, nil},                    // U+037A
+	{0x037B, nil},                    // U+037B
+	{0x037C, nil},                    // U+037C
+	{0x037D, nil},                    // U+037D
+	{0x003B, nil},                    // U+037E
+	{0x037F, nil},                    // U+037F
+	{0x0380, nil},                    // U+0380
+	{0x0381, nil},                    // U+0381
+	{0x0382, nil},                    // U+0382
+	{0x0383, nil},                    // U+0383
+	{0x0384, nil},                    // U+0384
+	{0x00A8, []rune{0x0301}},         // U+0385
+	{0x0391, []rune{0x0301}},         // U+0386
+	{0x00B7, nil},                    // U+0387
+	{0x0395, []rune{0x0301}},         // U+0388
+	{0x0397, []rune{0x0301}},         // U+0389
+	{0x0399, []rune{0x0301}},         // U+038A
+	{0x038B, nil},                    // U+038B
+	{0x039F, []rune{0x0301}},         // U+038C
+	{0x038D, nil},                    // U+038D
+	{0x03A5, []rune{0x0301}},         // U+038E
+	{0x03A9, []rune{0x0301}},         // U+038F
+	{0x03B9, []rune{0x0308, 0x0301}}, // U+0390
+	{0x0391, nil},                    // U+0391
+	{0x0392, nil},                    // U+0392
+	{0x0393, nil},                    // U+0393
+	{0x0394, nil},                    // U+0394
+	{0x0395, nil},                    // U+0395
+	{0x0396, nil},                    // U+0396
+	{0x0397, nil},                    // U+0397
+	{0x0398, nil},                    // U+0398
+	{0x0399, nil},                    // U+0399
+	{0x039A, nil},                    // U+039A
+	{0x039B, nil},                    // U+039B
+	{0x039C, nil},                    // U+039C
+	{0x039D, nil},                    // U+039D
+	{0x039E, nil},                    // U+039E
+	{0x039F, nil},                    // U+039F
+	{0x03A0, nil},                    // U+03A0
+	{0x03A1, nil},                    // U+03A1
+	{0x03A2, nil},                    // U+03A2
+	{0x03A3, nil},                    // U+03A3
+	{0x03A4, nil},                    // U+03A4
+	{0x03A5, nil},                    // U+03A5
+	{0x03A6, nil},                    // U+03A6
+	{0x03A7, nil},                    // U+03A7
+	{0x03A8, nil},                    // U+03A8
+	{0x03A9, nil},                    // U+03A9
+	{0x0399, []rune{0x0308}},         // U+03AA
+	{0x03A5, []rune{0x0308}},         // U+03AB
+	{0x03B1, []rune{0x0301}},         // U+03AC
+	{0x03B5, []rune{0x0301}},         // U+03AD
+	{0x03B7, []rune{0x0301}},         // U+03AE
+	{0x03B9, []rune{0x0301}},         // U+03AF
+	{0x03C5, []rune{0x0308, 0x0301}}, // U+03B0
+	{0x03B1, nil},                    // U+03B1
+	{0x03B2, nil},                    // U+03B2
+	{0x03B3, nil},                    // U+03B3
+	{0x03B4, nil},                    // U+03B4
+	{0x03B5, nil},                    // U+03B5
+	{0x03B6, nil},                    // U+03B6
+	{0x03B7, nil},                    // U+03B7
+	{0x03B8, nil},                    // U+03B8
+	{0x03B9, nil},                    // U+03B9
+	{0x03BA, nil},                    // U+03BA
+	{0x03BB, nil},                    // U+03BB
+	{0x03BC, nil},                    // U+03BC
+	{0x03BD, nil},                    // U+03BD
+	{0x03BE, nil},                    // U+03BE
+	{0x03BF, nil},                    // U+03BF
+	{0x03C0, nil},                    // U+03C0
+	{0x03C1, nil},                    // U+03C1
+	{0x03C2, nil},                    // U+03C2
+	{0x03C3, nil},                    // U+03C3
+	{0x03C4, nil},                    // U+03C4
+	{0x03C5, nil},                    // U+03C5
+	{0x03C6, nil},                    // U+03C6
+	{0x03C7, nil},                    // U+03C7
+	{0x03C8, nil},                    // U+03C8
+	{0x03C9, nil},                    // U+03C9
+	{0x03B9, []rune{0x0308}},         // U+03CA
+	{0x03C5, []rune{0x0308}},         // U+03CB
+	{0x03BF, []rune{0x0301}},         // U+03CC
+	{0x03C5, []rune{0x0301}},         // U+03CD
+	{0x03C9, []rune{0x0301}},         // U+03CE
+	{0x03CF, nil},                    // U+03CF
+	{0x03D0, nil},                    // U+03D0
+	{0x03D1, nil},                    // U+03D1
+	{0x03D2, nil},                    // U+03D2
+	{0x03D2, []rune{0x0301}},         // U+03D3
+	{0x03D2, []rune{0x0308}},         // U+03D4
+	{0x03D5, nil},                    // U+03D5
+	{0x03D6, nil},                    // U+03D6
+	{0x03D7, nil},                    // U+03D7
+	{0x03D8, nil},                    // U+03D8
+	{0x03D9, nil},                    // U+03D9
+	{0x03DA, nil},                    // U+03DA
+	{0x03DB, nil},                    // U+03DB
+	{0x03DC, nil},                    // U+03DC
+	{0x03DD, nil},                    // U+03DD
+	{0x03DE, nil},                    // U+03DE
+	{0x03DF, nil},                    // U+03DF
+	{0x03E0, nil},                    // U+03E0
+	{0x03E1, nil},                    // U+03E1
+	{0x03E2, nil},                    // U+03E2
+	{0x03E3, nil},                    // U+03E3
+	{0x03E4, nil},                    // U+03E4
+	{0x03E5, nil},                    // U+03E5
+	{0x03E6, nil},                    // U+03E6
+	{0x03E7, nil},                    // U+03E7
+	{0x03E8, nil},                    // U+03E8
+	{0x03E9, nil},                    // U+03E9
+	{0x03EA, nil},                    // U+03EA
+	{0x03EB, nil},                    // U+03EB
+	{0x03EC, nil},                    // U+03EC
+	{0x03ED, nil},                    // U+03ED
+	{0x03EE, nil},                    // U+03EE
+	{0x03EF, nil},                    // U+03EF
+	{0x03F0, nil},                    // U+03F0
+	{0x03F1, nil},                    // U+03F1
+	{0x03F2, nil},                    // U+03F2
+	{0x03F3, nil},                    // U+03F3
+	{0x03F4, nil},                    // U+03F4
+	{0x03F5, nil},                    // U+03F5
+	{0x03F6, nil},                    // U+03F6
+	{0x03F7, nil},                    // U+03F7
+	{0x03F8, nil},                    // U+03F8
+	{0x03F9, nil},                    // U+03F9
+	{0x03FA, nil},                    // U+03FA
+	{0x03FB, nil},                    // U+03FB
+	{0x03FC, nil},                    // U+03FC
+	{0x03FD, nil},                    // U+03FD
+	{0x03FE, nil},                    // U+03FE
+	{0x03FF, nil},                    // U+03FF
+}
+
+const greekExtendedFirst = 0x1F00
+const greekExtendedLast = 0x1FFF
+
+var greekExtendedDecompositions = [...]decomposition{
+	{0x03B1, []rune{0x0313}},                 // U+1F00
+	{0x03B1, []rune{0x0314}},                 // U+1F01
+	{0x03B1, []rune{0x0313, 0x0300}},         // U+1F02
+	{0x03B1, []rune{0x0314, 0x0300}},         // U+1F03
+	{0x03B1, []rune{0x0313, 0x0301}},         // U+1F04
+	{0x03B1, []rune{0x0314, 0x0301}},         // U+1F05
+	{0x03B1, []rune{0x0313, 0x0342}},         // U+1F06
+	{0x03B1, []rune{0x0314, 0x0342}},         // U+1F07
+	{0x0391, []rune{0x0313}},                 // U+1F08
+	{0x0391, []rune{0x0314}},                 // U+1F09
+	{0x0391, []rune{0x0313, 0x0300}},         // U+1F0A
+	{0x0391, []rune{0x0314, 0x0300}},         // U+1F0B
+	{0x0391, []rune{0x0313, 0x0301}},         // U+1F0C
+	{0x0391, []rune{0x0314, 0x0301}},         // U+1F0D
+	{0x0391, []rune{0x0313, 0x0342}},         // U+1F0E
+	{0x0391, []rune{0x0314, 0x0342}},         // U+1F0F
+	{0x03B5, []rune{0x0313}},                 // U+1F10
+	{0x03B5, []rune{0x0314}},                 // U+1F11
+	{0x03B5, []rune{0x0313, 0x0300}},         // U+1F12
+	{0x03B5, []rune{0x0314, 0x0300}},         // U+1F13
+	{0x03B5, []rune{0x0313, 0x0301}},         // U+1F14
+	{0x03B5, []rune{0x0314, 0x0301}},         // U+1F15
+	{0x1F16, nil},                            // U+1F16
+	{0x1F17, nil},                            // U+1F17
+	{0x0395, []rune{0x0313}},                 // U+1F18
+	{0x0395, []rune{0x0314}},                 // U+1F19
+	{0x0395, []rune{0x0313, 0x0300}},         // U+1F1A
+	{0x0395, []rune{0x0314, 0x0300}},         // U+1F1B
+	{0x0395, []rune{0x0313, 0x0301}},         // U+1F1C
+	{0x0395, []rune{0x0314, 0x0301}},         // U+1F1D
+	{0x1F1E, nil},                            // U+1F1E
+	{0x1F1F, nil},                            // U+1F1F
+	{0x03B7, []rune{0x0313}},                 // U+1F20
+	{0x03B7, []rune{0x0314}},                 // U+1F21
+	{0x03B7, []rune{0x0313, 0x0300}},         // U+1F22
+	{0x03B7, []rune{0x0314, 0x0300}},         // U+1F23
+	{0x03B7, []rune{0x0313, 0x0301}},         // U+1F24
+	{0x03B7, []rune{0x0314, 0x0301}},         // U+1F25
+	{0x03B7, []rune{0x0313, 0x0342}},         // U+1F26
+	{0x03B7, []rune{0x0314, 0x0342}},         // U+1F27
+	{0x0397, []rune{0x0313}},                 // U+1F28
+	{0x0397, []rune{0x0314}},                 // U+1F29
+	{0x0397, []rune{0x0313, 0x0300}},         // U+1F2A
+	{0x0397, []rune{0x0314, 0x0300}},         // U+1F2B
+	{0x0397, []rune{0x0313, 0x0301}},         // U+1F2C
+	{0x0397, []rune{0x0314, 0x0301}},         // U+1F2D
+	{0x0397, []rune{0x0313, 0x0342}},         // U+1F2E
+	{0x0397, []rune{0x0314, 0x0342}},         // U+1F2F
+	{0x03B9, []rune{0x0313}},                 // U+1F30
+	{0x03B9, []rune{0x0314}},                 // U+1F31
+	{0x03B9, []rune{0x0313, 0x0300}},         // U+1F32
+	{0x03B9, []rune{0x0314, 0x0300}},         // U+1F33
+	{0x03B9, []rune{0x0313, 0x0301}},         // U+1F34
+	{0x03B9, []rune{0x0314, 0x0301}},         // U+1F35
+	{0x03B9, []rune{0x0313, 0x0342}},         // U+1F36
+	{0x03B9, []rune{0x0314, 0x0342}},         // U+1F37
+	{0x0399, []rune{0x0313}},                 // U+1F38
+	{0x0399, []rune{0x0314}},                 // U+1F39
+	{0x0399, []rune{0x0313, 0x0300}},         // U+1F3A
+	{0x0399, []rune{0x0314, 0x0300}},         // U+1F3B
+	{0x0399, []rune{0x0313, 0x0301}},         // U+1F3C
+	{0x0399, []rune{0x0314, 0x0301}},         // U+1F3D
+	{0x0399, []rune{0x0313, 0x0342}},         // U+1F3E
+	{0x0399, []rune{0x0314, 0x0342}},         // U+1F3F
+	{0x03BF, []rune{0x0313}},                 // U+1F40
+	{0x03BF, []rune{0x0314}},                 // U+1F41
+	{0x03BF, []rune{0x0313, 0x0300}},         // U+1F42
+	{0x03BF, []rune{0x0314, 0x0300}},         // U+1F43
+	{0x03BF, []rune{0x0313, 0x0301}},         // U+1F44
+	{0x03BF, []rune{0x0314, 0x0301}},         // U+1F45
+	{0x1F46, nil},                            // U+1F46
+	{0x1F47, nil},                            // U+1F47
+	{0x039F, []rune{0x0313}},                 // U+1F48
+	{0x039F, []rune{0x0314}},                 // U+1F49
+	{0x039F, []rune{0x0313, 0x0300}},         // U+1F4A
+	{0x039F, []rune{0x0314, 0x0300}},         // U+1F4B
+	{0x039F, []rune{0x0313, 0x0301}},         // U+1F4C
+	{0x039F, []rune{0x0314, 0x0301}},         // U+1F4D
+	{0x1F4E, nil},                            // U+1F4E
+	{0x1F4F, nil},                            // U+1F4F
+	{0x03C5, []rune{0x0313}},                 // U+1F50
+	{0x03C5, []rune{0x0314}},                 // U+1F51
+	{0x03C5, []rune{0x0313, 0x0300}},         // U+1F52
+	{0x03C5, []rune{0x0314, 0x0300}},         // U+1F53
+	{0x03C5, []rune{0x0313, 0x0301}},         // U+1F54
+	{0x03C5, []rune{0x0314, 0x0301}},         // U+1F55
+	{0x03C5, []rune{0x0313, 0x0342}},         // U+1F56
+	{0x03C5, []rune{0x0314, 0x0342}},         // U+1F57
+	{0x1F58, nil},                            // U+1F58
+	{0x03A5, []rune{0x0314}},                 // U+1F59
+	{0x1F5A, nil},                            // U+1F5A
+	{0x03A5, []rune{0x0314, 0x0300}},         // U+1F5B
+	{0x1F5C, nil},                            // U+1F5C
+	{0x03A5, []rune{0x0314, 0x0301}},         // U+1F5D
+	{0x1F5E, nil},                            // U+1F5E
+	{0x03A5, []rune{0x0314, 0x0342}},         // U+1F5F
+	{0x03C9, []rune{0x0313}},                 // U+1F60
+	{0x03C9, []rune{0x0314}},                 // U+1F61
+	{0x03C9, []rune{0x0313, 0x0300}},         // U+1F62
+	{0x03C9, []rune{0x0314, 0x0300}},         // U+1F63
+	{0x03C9, []rune{0x0313, 0x0301}},         // U+1F64
+	{0x03C9, []rune{0x0314, 0x0301}},         // U+1F65
+	{0x03C9, []rune{0x0313, 0x0342}},         // U+1F66
+	{0x03C9, []rune{0x0314, 0x0342}},         // U+1F67
+	{0x03A9, []rune{0x0313}},                 // U+1F68
+	{0x03A9, []rune{0x0314}},                 // U+1F69
+	{0x03A9, []rune{0x0313, 0x0300}},         // U+1F6A
+	{0x03A9, []rune{0x0314, 0x0300}},         // U+1F6B
+	{0x03A9, []rune{0x0313, 0x0301}},         // U+1F6C
+	{0x03A9, []rune{0x0314, 0x0301}},         // U+1F6D
+	{0x03A9, []rune{0x0313, 0x0342}},         // U+1F6E
+	{0x03A9, []rune{0x0314, 0x0342}},         // U+1F6F
+	{0x03B1, []rune{0x0300}},                 // U+1F70
+	{0x03B1, []rune{0x0301}},                 // U+1F71
+	{0x03B5, []rune{0x0300}},                 // U+1F72
+	{0x03B5, []rune{0x0301}},                 // U+1F73
+	{0x03B7, []rune{0x0300}},                 // U+1F74
+	{0x03B7, []rune{0x0301}},                 // U+1F75
+	{0x03B9, []rune{0x0300}},                 // U+1F76
+	{0x03B9, []rune{0x0301}},                 // U+1F77
+	{0x03BF, []rune{0x0300}},                 // U+1F78
+	{0x03BF, []rune{0x0301}},                 // U+1F79
+	{0x03C5, []rune{0x0300}},                 // U+1F7A
+	{0x03C5, []rune{0x0301}},                 // U+1F7B
+	{0x03C9, []rune{0x0300}},                 // U+1F7C
+	{0x03C9, []rune{0x0301}},                 // U+1F7D
+	{0x1F7E, nil},                            // U+1F7E
+	{0x1F7F, nil},                            // U+1F7F
+	{0x03B1, []rune{0x0313, 0x0345}},         // U+1F80
+	{0x03B1, []rune{0x0314, 0x0345}},         // U+1F81
+	{0x03B1, []rune{0x0313, 0x0300, 0x0345}}, // U+1F82
+	{0x03B1, []rune{0x0314, 0x0300, 0x0345}}, // U+1F83
+	{0x03B1, []rune{0x0313, 0x0301, 0x0345}}, // U+1F84
+	{0x03B1, []rune{0x0314, 0x0301, 0x0345}}, // U+1F85
+	{0x03B1, []rune{0x0313, 0x0342, 0x0345}}, // U+1F86
+	{0x03B1, []rune{0x0314, 0x0342, 0x0345}}, // U+1F87
+	{0x0391, []rune{0x0313, 0x0345}},         // U+1F88
+	{0x0391, []rune{0x0314, 0x0345}},         // U+1F89
+	{0x0391, []rune{0x0313, 0x0300, 0x0345}}, // U+1F8A
+	{0x0391, []rune{0x0314, 0x0300, 0x0345}}, // U+1F8B
+	{0x0391, []rune{0x0313, 0x0301, 0x0345}}, // U+1F8C
+	{0x0391, []rune{0x0314, 0x0301, 0x0345}}, // U+1F8D
+	{0x0391, []rune{0x0313, 0x0342, 0x0345}}, // U+1F8E
+	{0x0391, []rune{0x0314, 0x0342, 0x0345}}, // U+1F8F
+	{0x03B7, []rune{0x0313, 0x0345}},         // U+1F90
+	{0x03B7, []rune{0x0314, 0x0345}},         // U+1F91
+	{0x03B7, []rune{0x0313, 0x0300, 0x0345}}, // U+1F92
+	{0x03B7, []rune{0x0314, 0x0300, 0x0345}}, // U+1F93
+	{0x03B7, []rune{0x0313, 0x0301, 0x0345}}, // U+1F94
+	{0x03B7, []rune{0x0314, 0x0301, 0x0345}}, // U+1F95
+	{0x03B7, []rune{0x0313, 0x0342, 0x0345}}, // U+1F96
+	{0x03B7, []rune{0x0314, 0x0342, 0x0345}}, // U+1F97
+	{0x0397, []rune{0x0313, 0x0345}},         // U+1F98
+	{0x0397, []rune{0x0314, 0x0345}},         // U+1F99
+	{0x0397, []rune{0x0313, 0x0300, 0x0345}}, // U+1F9A
+	{0x0397, []rune{0x0314, 0x0300, 0x0345}}, // U+1F9B
+	{0x0397, []rune{0x0313, 0x0301, 0x0345}}, // U+1F9C
+	{0x0397, []rune{0x0314, 0x0301, 0x0345}}, // U+1F9D
+	{0x0397, []rune{0x0313, 0x0342, 0x0345}}, // U+1F9E
+	{0x0397, []rune{0x0314, 0x0342, 0x0345}}, // U+1F9F
+	{0x03C9, []rune{0x0313, 0x0345}},         // U+1FA0
+	{0x03C9, []rune{0x0314, 0x0345}},         // U+1FA1
+	{0x03C9, []rune{0x0313, 0x0300, 0x0345}}, // U+1FA2
+	{0x03C9, []rune{0x0314, 0x0300, 0x0345}}, // U+1FA3
+	{0x03C9, []rune{0x0313, 0x0301, 0x0345}}, // U+1FA4
+	{0x03C9, []rune{0x0314, 0x0301, 0x0345}}, // U+1FA5
+	{0x03C9, []rune{0x0313, 0x0342, 0x0345}}, // U+1FA6
+	{0x03C9, []rune{0x0314, 0x0342, 0x0345}}, // U+1FA7
+	{0x03A9, []rune{0x0313, 0x0345}},         // U+1FA8
+	{0x03A9, []rune{0x0314, 0x0345}},         // U+1FA9
+	{0x03A9, []rune{0x0313, 0x0300, 0x0345}}, // U+1FAA
+	{0x03A9, []rune{0x0314, 0x0300, 0x0345}}, // U+1FAB
+	{0x03A9, []rune{0x0313, 0x0301, 0x0345}}, // U+1FAC
+	{0x03A9, []rune{0x0314, 0x0301, 0x0345}}, // U+1FAD
+	{0x03A9, []rune{0x0313, 0x0342, 0x0345}}, // U+1FAE
+	{0x03A9, []rune{0x0314, 0x0342, 0x0345}}, // U+1FAF
+	{0x03B1, []rune{0x0306}},                 // U+1FB0
+	{0x03B1, []rune{0x0304}},                 // U+1FB1
+	{0x03B1, []rune{0x0300, 0x0345}},         // U+1FB2
+	{0x03B1, []rune{0x0345}},                 // U+1FB3
+	{0x03B1, []rune{0x0301, 0x0345}},         // U+1FB4
+	{0x1FB5, nil},                            // U+1FB5
+	{0x03B1, []rune{0x0342}},                 // U+1FB6
+	{0x03B1, []rune{0x0342, 0x0345}},         // U+1FB7
+	{0x0391, []rune{0x0306}},                 // U+1FB8
+	{0x0391, []rune{0x0304}},                 // U+1FB9
+	{0x0391, []rune{0x0300}},                 // U+1FBA
+	{0x0391, []rune{0x0301}},                 // U+1FBB
+	{0x0391, []rune{0x0345}},                 // U+1FBC
+	{0x1FBD, nil},                            // U+1FBD
+	{0x03B9, nil},                            // U+1FBE
+	{0x1FBF, nil},                            // U+1FBF
+	{0x1FC0, nil},                            // U+1FC0
+	{0x00A8, []rune{0x0342}},                 // U+1FC1
+	{0x03B7, []rune{0x0300, 0x0345}},         // U+1FC2
+	{0x03B7, []rune{0x0345}},                 // U+1FC3
+	{0x03B7, []rune{0x0301, 0x0345}},         // U+1FC4
+	{0x1FC5, nil},                            // U+1FC5
+	{0x03B7, []rune{0x0342}},                 // U+1FC6
+	{0x03B7, []rune{0x0342, 0x0345}},         // U+1FC7
+	{0x0395, []rune{0x0300}},                 // U+1FC8
+	{0x0395, []rune{0x0301}},                 // U+1FC9
+	{0x0397, []rune{0x0300}},                 // U+1FCA
+	{0x0397, []rune{0x0301}},                 // U+1FCB
+	{0x0397, []rune{0x0345}},                 // U+1FCC
+	{0x1FBF, []rune{0x0300}},                 // U+1FCD
+	{0x1FBF, []rune{0x0301}},                 // U+1FCE
+	{0x1FBF, []rune{0x0342}},                 // U+1FCF
+	{0x03B9, []rune{0x0306}},                 // U+1FD0
+	{0x03B9, []rune{0x0304}},                 // U+1FD1
+	{0x03B9, []rune{0x0308, 0x0300}},         // U+1FD2
+	{0x03B9, []rune{0x0308, 0x0301}},         // U+1FD3
+	{0x1FD4, nil},                            // U+1FD4
+	{0x1FD5, nil},                            // U+1FD5
+	{0x03B9, []rune{0x0342}},                 // U+1FD6
+	{0x03B9, []rune{0x0308, 0x0342}},         // U+1FD7
+	{0x0399, []rune{0x0306}},                 // U+1FD8
+	{0x0399, []rune{0x0304}},                 // U+1FD9
+	{0x0399, []rune{0x0300}},                 // U+1FDA
+	{0x0399, []rune{0x0301}},                 // U+1FDB
+	{0x1FDC, nil},                            // U+1FDC
+	{0x1FFE, []rune{0x0300}},                 // U+1FDD
+	{0x1FFE, []rune{0x0301}},                 // U+1FDE
+	{0x1FFE, []rune{0x0342}},                 // U+1FDF
+	{0x03C5, []rune{0x0306}},                 // U+1FE0
+	{0x03C5, []rune{0x0304}},                 // U+1FE1
+	{0x03C5, []rune{0x0308, 0x0300}},         // U+1FE2
+	{0x03C5, []rune{0x0308, 0x0301}},         // U+1FE3
+	{0x03C1, []rune{0x0313}},                 // U+1FE4
+	{0x03C1, []rune{0x0314}},                 // U+1FE5
+	{0x03C5, []rune{0x0342}},                 // U+1FE6
+	{0x03C5, []rune{0x0308, 0x0342}},         // U+1FE7
+	{0x03A5, []rune{0x0306}},                 // U+1FE8
+	{0x03A5, []rune{0x0304}},                 // U+1FE9
+	{0x03A5, []rune{0x0300}},                 // U+1FEA
+	{0x03A5, []rune{0x0301}},                 // U+1FEB
+	{0x03A1, []rune{0x0314}},                 // U+1FEC
+	{0x00A8, []rune{0x0300}},                 // U+1FED
+	{0x00A8, []rune{0x0301}},                 // U+1FEE
+	{0x0060, nil},                            // U+1FEF
+	{0x1FF0, nil},                            // U+1FF0
+	{0x1FF1, nil},                            // U+1FF1
+	{0x03C9, []rune{0x0300, 0x0345}},         // U+1FF2
+	{0x03C9, []rune{0x0345}},                 // U+1FF3
+	{0x03C9, []rune{0x0301, 0x0345}},         // U+1FF4
+	{0x1FF5, nil},                            // U+1FF5
+	{0x03C9, []rune{0x0342}},                 // U+1FF6
+	{0x03C9, []rune{0x0342, 0x0345}},         // U+1FF7
+	{0x039F, []rune{0x0300}},                 // U+1FF8
+	{0x039F, []rune{0x0301}},                 // U+1FF9
+	{0x03A9, []rune{0x0300}},                 // U+1FFA
+	{0x03A9, []rune{0x0301}},                 // U+1FFB
+	{0x03A9, []rune{0x0345}},                 // U+1FFC
+	{0x00B4, nil},                            // U+1FFD
+	{0x1FFE, nil},                            // U+1FFE
+	{0x1FFF, nil},                            // U+1FFF
+}
+
+var greekCompositions = map[string]rune{
+	";":                        0x003B,
+	"`":                        0x0060,
+	"\u00a8\u0300":             0x1FED,
+	"\u00a8\u0301":             0x0385,
+	"\u00a8\u0342":             0x1FC1,
+	"\u00b4":                   0x00B4,
+	"\u00b7":                   0x00B7,
+	"\u02b9":                   0x02B9,
+	"\u0370":                   0x0370,
+	"\u0371":                   0x0371,
+	"\u0372":                   0x0372,
+	"\u0373":                   0x0373,
+	"\u0375":                   0x0375,
+	"\u0376":                   0x0376,
+	"\u0377":                   0x0377,
+	"\u0378":                   0x0378,
+	"\u0379":                   0x0379,
+	"\u037a":                   0x037A,
+	"\u037b":                   0x037B,
+	"\u037c":                   0x037C,
+	"\u037d":                   0x037D,
+	"\u037f":                   0x037F,
+	"\u0380":                   0x0380,
+	"\u0381":                   0x0381,
+	"\u0382":                   0x0382,
+	"\u0383":                   0x0383,
+	"\u0384":                   0x0384,
+	"\u038b":                   0x038B,
+	"\u038d":                   0x038D,
+	"\u0391":                   0x0391,
+	"\u0391\u0300":             0x1FBA,
+	"\u0391\u0301":             0x0386,
+	"\u0391\u0304":             0x1FB9,
+	"\u0391\u0306":             0x1FB8,
+	"\u0391\u0313":             0x1F08,
+	"\u0391\u0313\u0300":       0x1F0A,
+	"\u0391\u0313\u0300\u0345": 0x1F8A,
+	"\u0391\u0313\u0301":       0x1F0C,
+	"\u0391\u0313\u0301\u0345": 0x1F8C,
+	"\u0391\u0313\u0342":       0x1F0E,
+	"\u0391\u0313\u0342\u0345": 0x1F8E,
+	"\u0391\u0313\u0345":       0x1F88,
+	"\u0391\u0314":             0x1F09,
+	"\u0391\u0314\u0300":       0x1F0B,
+	"\u0391\u0314\u0300\u0345": 0x1F8B,
+	"\u0391\u0314\u0301":       0x1F0D,
+	"\u0391\u0314\u0301\u0345": 0x1F8D,
+	"\u0391\u0314\u0342":       0x1F0F,
+	"\u0391\u0314\u0342\u0345": 0x1F8F,
+	"\u0391\u0314\u0345":       0x1F89,
+	"\u0391\u0345":             0x1FBC,
+	"\u0392":                   0x0392,
+	"\u0393":                   0x0393,
+	"\u0394":                   0x0394,
+	"\u0395":                   0x0395,
+	"\u0395\u0300":             0x1FC8,
+	"\u0395\u0301":             0x0388,
+	"\u0395\u0313":             0x1F18,
+	"\u0395\u0313\u0300":       0x1F1A,
+	"\u0395\u0313\u0301":       0x1F1C,
+	"\u0395\u0314":             0x1F19,
+	"\u0395\u0314\u0300":       0x1F1B,
+	"\u0395\u0314\u0301":       0x1F1D,
+	"\u0396":                   0x0396,
+	"\u0397":                   0x0397,
+	"\u0397\u0300":             0x1FCA,
+	"\u0397\u0301":             0x0389,
+	"\u0397\u0313":             0x1F28,
+	"\u0397\u0313\u0300":       0x1F2A,
+	"\u0397\u0313\u0300\u0345": 0x1F9A,
+	"\u0397\u0313\u0301":       0x1F2C,
+	"\u0397\u0313\u0301\u0345": 0x1F9C,
+	"\u0397\u0313\u0342":       0x1F2E,
+	"\u0397\u0313\u0342\u0345": 0x1F9E,
+	"\u0397\u0313\u0345":       0x1F98,
+	"\u0397\u0314":             0x1F29,
+	"\u0397\u0314\u0300":       0x1F2B,
+	"\u0397\u0314\u0300\u0345": 0x1F9B,
+	"\u0397\u0314\u0301":       0x1F2D,
+	"\u0397\u0314\u0301\u0345": 0x1F9D,
+	"\u0397\u0314\u0342":       0x1F2F,
+	"\u0397\u0314\u0342\u0345": 0x1F9F,
+	"\u0397\u0314\u0345":       0x1F99,
+	"\u0397\u0345":             0x1FCC,
+	"\u0398":                   0x0398,
+	"\u0399":                   0x0399,
+	"\u0399\u0300":             0x1FDA,
+	"\u0399\u0301":             0x038A,
+	"\u0399\u0304":             0x1FD9,
+	"\u0399\u0306":             0x1FD8,
+	"\u0399\u0308":             0x03AA,
+	"\u0399\u0313":             0x1F38,
+	"\u0399\u0313\u0300":       0x1F3A,
+	"\u0399\u0313\u0301":       0x1F3C,
+	"\u0399\u0313\u0342":       0x1F3E,
+	"\u0399\u0314":             0x1F39,
+	"\u0399\u0314\u0300":       0x1F3B,
+	"\u0399\u0314\u0301":       0x1F3D,
+	"\u0399\u0314\u0342":       0x1F3F,
+	"\u039a":                   0x039A,
+	"\u039b":                   0x039B,
+	"\u039c":                   0x039C,
+	"\u039d":                   0x039D,
+	"\u039e":                   0x039E,
+	"\u039f":                   0x039F,
+	"\u039f\u0300":             0x1FF8,
+	"\u039f\u0301":             0x038C,
+	"\u039f\u0313":             0x1F48,
+	"\u039f\u0313\u0300":       0x1F4A,
+	"\u039f\u0313\u0301":       0x1F4C,
+	"\u039f\u0314":             0x1F49,
+	"\u039f\u0314\u0300":       0x1F4B,
+	"\u039f\u0314\u0301":       0x1F4D,
+	"\u03a0":                   0x03A0,
+	"\u03a1":                   0x03A1,
+	"\u03a1\u0314":             0x1FEC,
+	"\u03a2":                   0x03A2,
+	"\u03a3":                   0x03A3,
+	"\u03a4":                   0x03A4,
+	"\u03a5":                   0x03A5,
+	"\u03a5\u0300":             0x1FEA,
+	"\u03a5\u0301":             0x038E,
+	"\u03a5\u0304":             0x1FE9,
+	"\u03a5\u0306":             0x1FE8,
+	"\u03a5\u0308":             0x03AB,
+	"\u03a5\u0314":             0x1F59,
+	"\u03a5\u0314\u0300":       0x1F5B,
+	"\u03a5\u0314\u0301":       0x1F5D,
+	"\u03a5\u0314\u0342":       0x1F5F,
+	"\u03a6":                   0x03A6,
+	"\u03a7":                   0x03A7,
+	"\u03a8":                   0x03A8,
+	"\u03a9":                   0x03A9,
+	"\u03a9\u0300":             0x1FFA,
+	"\u03a9\u0301":             0x038F,
+	"\u03a9\u0313":             0x1F68,
+	"\u03a9\u0313\u0300":       0x1F6A,
+	"\u03a9\u0313\u0300\u0345": 0x1FAA,
+	"\u03a9\u0313\u0301":       0x1F6C,
+	"\u03a9\u0313\u0301\u0345": 0x1FAC,
+	"\u03a9\u0313\u0342":       0x1F6E,
+	"\u03a9\u0313\u0342\u0345": 0x1FAE,
+	"\u03a9\u0313\u0345":       0x1FA8,
+	"\u03a9\u0314":             0x1F69,
+	"\u03a9\u0314\u0300":       0x1F6B,
+	"\u03a9\u0314\u0300\u0345": 0x1FAB,
+	"\u03a9\u0314\u0301":       0x1F6D,
+	"\u03a9\u0314\u0301\u0345": 0x1FAD,
+	"\u03a9\u0314\u0342":       0x1F6F,
+	"\u03a9\u0314\u0342\u0345": 0x1FAF,
+	"\u03a9\u0314\u0345":       0x1FA9,
+	"\u03a9\u0345":             0x1FFC,
+	"\u03b1":                   0x03B1,
+	"\u03b1\u0300":             0x1F70,
+	"\u03b1\u0300\u0345":       0x1FB2,
+	"\u03b1\u0301":             0x03AC,
+	"\u03b1\u0301\u0345":       0x1FB4,
+	"\u03b1\u0304":             0x1FB1,
+	"\u03b1\u0306":             0x1FB0,
+	"\u03b1\u0313":             0x1F00,
+	"\u03b1\u0313\u0300":       0x1F02,
+	"\u03b1\u0313\u0300\u0345": 0x1F82,
+	"\u03b1\u0313\u0301":       0x1F04,
+	"\u03b1\u0313\u0301\u0345": 0x1F84,
+	"\u03b1\u0313\u0342":       0x1F06,
+	"\u03b1\u0313\u0342\u0345": 0x1F86,
+	"\u03b1\u0313\u0345":       0x1F80,
+	"\u03b1\u0314":             0x1F01,
+	"\u03b1\u0314\u0300":       0x1F03,
+	"\u03b1\u0314\u0300\u0345": 0x1F83,
+	"\u03b1\u0314\u0301":       0x1F05,
+	"\u03b1\u0314\u0301\u0345": 0x1F85,
+	"\u03b1\u0314\u0342":       0x1F07,
+	"\u03b1\u0314\u0342\u0345": 0x1F87,
+	"\u03b1\u0314\u0345":       0x1F81,
+	"\u03b1\u0342":             0x1FB6,
+	"\u03b1\u0342\u0345":       0x1FB7,
+	"\u03b1\u0345":             0x1FB3,
+	"\u03b2":                   0x03B2,
+	"\u03b3":                   0x03B3,
+	"\u03b4":                   0x03B4,
+	"\u03b5":                   0x03B5,
+	"\u03b5\u0300":             0x1F72,
+	"\u03b5\u0301":             0x03AD,
+	"\u03b5\u0313":             0x1F10,
+	"\u03b5\u0313\u0300":       0x1F12,
+	"\u03b5\u0313\u0301":       0x1F14,
+	"\u03b5\u0314":             0x1F11,
+	"\u03b5\u0314\u0300":       0x1F13,
+	"\u03b5\u0314\u0301":       0x1F15,
+	"\u03b6":                   0x03B6,
+	"\u03b7":                   0x03B7,
+	"\u03b7\u0300":             0x1F74,
+	"\u03b7\u0300\u0345":       0x1FC2,
+	"\u03b7\u0301":             0x03AE,
+	"\u03b7\u0301\u0345":       0x1FC4,
+	"\u03b7\u0313":             0x1F20,
+	"\u03b7\u0313\u0300":       0x1F22,
+	"\u03b7\u0313\u0300\u0345": 0x1F92,
+	"\u03b7\u0313\u0301":       0x1F24,
+	"\u03b7\u0313\u0301\u0345": 0x1F94,
+	"\u03b7\u0313\u0342":       0x1F26,
+	"\u03b7\u0313\u0342\u0345": 0x1F96,
+	"\u03b7\u0313\u0345":       0x1F90,
+	"\u03b7\u0314":             0x1F21,
+	"\u03b7\u0314\u0300":       0x1F23,
+	"\u03b7\u0314\u0300\u0345": 0x1F93,
+	"\u03b7\u0314\u0301":       0x1F25,
+	"\u03b7\u0314\u0301\u0345": 0x1F95,
+	"\u03b7\u0314\u0342":       0x1F27,
+	"\u03b7\u0314\u0342\u0345": 0x1F97,
+	"\u03b7\u0314\u0345":       0x1F91,
+	"\u03b7\u0342":             0x1FC6,
+	"\u03b7\u0342\u0345":       0x1FC7,
+	"\u03b7\u0345":             0x1FC3,
+	"\u03b8":                   0x03B8,
+	"\u03b9":                   0x03B9,
+	"\u03b9\u0300":             0x1F76,
+	"\u03b9\u0301":             0x03AF,
+	"\u03b9\u0304":             0x1FD1,
+	"\u03b9\u0306":             0x1FD0,
+	"\u03b9\u0308":             0x03CA,
+	"\u03b9\u0308\u0300":       0x1FD2,
+	"\u03b9\u0308\u0301":       0x0390,
+	"\u03b9\u0308\u0342":       0x1FD7,
+	"\u03b9\u0313":             0x1F30,
+	"\u03b9\u0313\u0300":       0x1F32,
+	"\u03b9\u0313\u0301":       0x1F34,
+	"\u03b9\u0313\u0342":       0x1F36,
+	"\u03b9\u0314":             0x1F31,
+	"\u03b9\u0314\u0300":       0x1F33,
+	"\u03b9\u0314\u0301":       0x1F35,
+	"\u03b9\u0314\u0342":       0x1F37,
+	"\u03b9\u0342":             0x1FD6,
+	"\u03ba":                   0x03BA,
+	"\u03bb":                   0x03BB,
+	"\u03bc":                   0x03BC,
+	"\u03bd":                   0x03BD,
+	"\u03be":                   0x03BE,
+	"\u03bf":                   0x03BF,
+	"\u03bf\u0300":             0x1F78,
+	"\u03bf\u0301":             0x03CC,
+	"\u03bf\u0313":             0x1F40,
+	"\u03bf\u0313\u0300":       0x1F42,
+	"\u03bf\u0313\u0301":       0x1F44,
+	"\u03bf\u0314":             0x1F41,
+	"\u03bf\u0314\u0300":       0x1F43,
+	"\u03bf\u0314\u0301":       0x1F45,
+	"\u03c0":                   0x03C0,
+	"\u03c1":                   0x03C1,
+	"\u03c1\u0313":             0x1FE4,
+	"\u03c1\u0314":             0x1FE5,
+	"\u03c2":                   0x03C2,
+	"\u03c3":                   0x03C3,
+	"\u03c4":                   0x03C4,
+	"\u03c5":                   0x03C5,
+	"\u03c5\u0300":             0x1F7A,
+	"\u03c5\u0301":             0x03CD,
+	"\u03c5\u0304":             0x1FE1,
+	"\u03c5\u0306":             0x1FE0,
+	"\u03c5\u0308":             0x03CB,
+	"\u03c5\u0308\u0300":       0x1FE2,
+	"\u03c5\u0308\u0301":       0x03B0,
+	"\u03c5\u0308\u0342":       0x1FE7,
+	"\u03c5\u0313":             0x1F50,
+	"\u03c5\u0313\u0300":       0x1F52,
+	"\u03c5\u0313\u0301":       0x1F54,
+	"\u03c5\u0313\u0342":       0x1F56,
+	"\u03c5\u0314":             0x1F51,
+	"\u03c5\u0314\u0300":       0x1F53,
+	"\u03c5\u0314\u0301":       0x1F55,
+	"\u03c5\u0314\u0342":       0x1F57,
+	"\u03c5\u0342":             0x1FE6,
+	"\u03c6":                   0x03C6,
+	"\u03c7":                   0x03C7,
+	"\u03c8":                   0x03C8,
+	"\u03c9":                   0x03C9,
+	"\u03c9\u0300":             0x1F7C,
+	"\u03c9\u0300\u0345":       0x1FF2,
+	"\u03c9\u0301":             0x03CE,
+	"\u03c9\u0301\u0345":       0x1FF4,
+	"\u03c9\u0313":             0x1F60,
+	"\u03c9\u0313\u0300":       0x1F62,
+	"\u03c9\u0313\u0300\u0345": 0x1FA2,
+	"\u03c9\u0313\u0301":       0x1F64,
+	"\u03c9\u0313\u0301\u0345": 0x1FA4,
+	"\u03c9\u0313\u0342":       0x1F66,
+	"\u03c9\u0313\u0342\u0345": 0x1FA6,
+	"\u03c9\u0313\u0345":       0x1FA0,
+	"\u03c9\u0314":             0x1F61,
+	"\u03c9\u0314\u0300":       0x1F63,
+	"\u03c9\u0314\u0300\u0345": 0x1FA3,
+	"\u03c9\u0314\u0301":       0x1F65,
+	"\u03c9\u0314\u0301\u0345": 0x1FA5,
+	"\u03c9\u0314\u0342":       0x1F67,
+	"\u03c9\u0314\u0342\u0345": 0x1FA7,
+	"\u03c9\u0314\u0345":       0x1FA1,
+	"\u03c9\u0342":             0x1FF6,
+	"\u03c9\u0342\u0345":       0x1FF7,
+	"\u03c9\u0345":             0x1FF3,
+	"\u03cf":                   0x03CF,
+	"\u03d0":                   0x03D0,
+	"\u03d1":                   0x03D1,
+	"\u03d2":                   0x03D2,
+	"\u03d2\u0301":             0x03D3,
+	"\u03d2\u0308":             0x03D4,
+	"\u03d5":                   0x03D5,
+	"\u03d6":                   0x03D6,
+	"\u03d7":                   0x03D7,
+	"\u03d8":                   0x03D8,
+	"\u03d9":                   0x03D9,
+	"\u03da":                   0x03DA,
+	"\u03db":                   0x03DB,
+	"\u03dc":                   0x03DC,
+	"\u03dd":                   0x03DD,
+	"\u03de":                   0x03DE,
+	"\u03df":                   0x03DF,
+	"\u03e0":                   0x03E0,
+	"\u03e1":                   0x03E1,
+	"\u03e2":                   0x03E2,
+	"\u03e3":                   0x03E3,
+	"\u03e4":                   0x03E4,
+	"\u03e5":                   0x03E5,
+	"\u03e6":                   0x03E6,
+	"\u03e7":                   0x03E7,
+	"\u03e8":                   0x03E8,
+	"\u03e9":                   0x03E9,
+	"\u03ea":                   0x03EA,
+	"\u03eb":                   0x03EB,
+	"\u03ec":                   0x03EC,
+	"\u03ed":                   0x03ED,
+	"\u03ee":                   0x03EE,
+	"\u03ef":                   0x03EF,
+	"\u03f0":                   0x03F0,
+	"\u03f1":                   0x03F1,
+	"\u03f2":                   0x03F2,
+	"\u03f3":                   0x03F3,
+	"\u03f4":                   0x03F4,
+	"\u03f5":                   0x03F5,
+	"\u03f6":                   0x03F6,
+	"\u03f7":                   0x03F7,
+	"\u03f8":                   0x03F8,
+	"\u03f9":                   0x03F9,
+	"\u03fa":                   0x03FA,
+	"\u03fb":                   0x03FB,
+	"\u03fc":                   0x03FC,
+	"\u03fd":                   0x03FD,
+	"\u03fe":                   0x03FE,
+	"\u03ff":                   0x03FF,
+	"\u1f16":                   0x1F16,
+	"\u1f17":                   0x1F17,
+	"\u1f1e":                   0x1F1E,
+	"\u1f1f":                   0x1F1F,
+	"\u1f46":                   0x1F46,
+	"\u1f47":                   0x1F47,
+	"\u1f4e":                   0x1F4E,
+	"\u1f4f":                   0x1F4F,
+	"\u1f58":                   0x1F58,
+	"\u1f5a":                   0x1F5A,
+	"\u1f5c":                   0x1F5C,
+	"\u1f5e":                   0x1F5E,
+	"\u1f7e":                   0x1F7E,
+	"\u1f7f":                   0x1F7F,
+	"\u1fb5":                   0x1FB5,
+	"\u1fbd":                   0x1FBD,
+	"\u1fbf":                   0x1FBF,
+	"\u1fbf\u0300":             0x1FCD,
+	"\u1fbf\u0301":             0x1FCE,
+	"\u1fbf\u0342":             0x1FCF,
+	"\u1fc0":                   0x1FC0,
+	"\u1fc5":                   0x1FC5,
+	"\u1fd4":                   0x1FD4,
+	"\u1fd5":                   0x1FD5,
+	"\u1fdc":                   0x1FDC,
+	"\u1ff0":                   0x1FF0,
+	"\u1ff1":                   0x1FF1,
+	"\u1ff5":                   0x1FF5,
+	"\u1ffe":                   0x1FFE,
+	"\u1ffe\u0300":             0x1FDD,
+	"\u1ffe\u0301":             0x1FDE,
+	"\u1ffe\u0342":             0x1FDF,
+	"\u1fff":                   0x1FFF,
+}
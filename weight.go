@@ -0,0 +1,84 @@
+package greekaccentuation
+
+// SyllableWeight is the metrical weight of a syllable. It differs from
+// the vowel Length of the syllable: a syllable with a short vowel is
+// heavy when two or more consonants follow it.
+type SyllableWeight int
+
+const (
+	UNKNOWN_WEIGHT SyllableWeight = 0
+	LIGHT          SyllableWeight = 1
+	HEAVY          SyllableWeight = 2
+)
+
+func (e SyllableWeight) Name() string {
+	switch e {
+	case UNKNOWN_WEIGHT:
+		return "UNKNOWN_WEIGHT"
+	case LIGHT:
+		return "LIGHT"
+	case HEAVY:
+		return "HEAVY"
+	}
+	return ""
+}
+
+// WeightOptions controls how Weight treats the doubtful cases.
+type WeightOptions struct {
+	// MutaCumLiquidaLight leaves a short vowel light before a stop
+	// followed by a liquid or nasal in the same word, as is usual in
+	// Attic. Otherwise the cluster makes the syllable heavy.
+	MutaCumLiquidaLight bool
+	// Hiatus makes a word final long vowel or diphthong light before a
+	// word beginning with a vowel (epic correption).
+	Hiatus bool
+}
+
+// WeightedSyllable is a syllable of a phrase with its weight.
+type WeightedSyllable struct {
+	Syllable string // syllable after resyllabification across words
+	Word     int    // index of the word the syllable belongs to
+	Length   Length // length of the vowel
+	Weight   SyllableWeight
+	// Position is true if the syllable is heavy because of the
+	// consonants that follow it rather than its vowel.
+	Position bool
+}
+
+// weight returns the weight of a metrical syllable.
+func (s metricalSyllable) weight(options WeightOptions) (SyllableWeight, bool) {
+	if s.consonants >= 2 && !(s.mutaCumLiquida && options.MutaCumLiquidaLight) {
+		return HEAVY, s.natural != LONG
+	}
+	switch s.natural {
+	case LONG:
+		if s.hiatus && options.Hiatus {
+			return LIGHT, false
+		}
+		return HEAVY, false
+	case SHORT:
+		return LIGHT, false
+	}
+	return UNKNOWN_WEIGHT, false
+}
+
+// Weight splits a phrase into syllables across word boundaries and marks
+// each one heavy or light. A syllable is heavy if its vowel is long or
+// if it is followed by two or more consonants, in the same word or the
+// next, counting ζ ξ ψ as two. The weight of a syllable whose vowel is
+// α, ι or υ of unknown length and which is not heavy by position is
+// UNKNOWN_WEIGHT.
+func Weight(phrase string, options WeightOptions) []WeightedSyllable {
+	var result []WeightedSyllable
+	for _, s := range metricalSyllables(verseWords(phrase)) {
+		w, position := s.weight(options)
+		result = append(result, WeightedSyllable{
+			Syllable: s.text,
+			Word:     s.word,
+			Length:   s.natural,
+			Weight:   w,
+			Position: position,
+		})
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package greekaccentuation
+
+import "testing"
+
+func weights(w []WeightedSyllable) []SyllableWeight {
+	var result []SyllableWeight
+	for _, s := range w {
+		result = append(result, s.Weight)
+	}
+	return result
+}
+
+func weightsEqual(a, b []SyllableWeight) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestWeight(t *testing.T) {
+	w := Weight("ὃς μάλα πολλὰ", WeightOptions{})
+	if !weightsEqual(weights(w), []SyllableWeight{HEAVY, UNKNOWN_WEIGHT, UNKNOWN_WEIGHT, HEAVY, UNKNOWN_WEIGHT}) {
+		t.Fatalf("Weight() failed. Returned %v", w)
+	}
+	if !w[0].Position || w[0].Length != SHORT {
+		t.Fatalf("Weight() failed. ὃς should be heavy by position. Returned %v", w[0])
+	}
+
+	// ξ counts as two consonants
+	w = Weight("ἄξιος", WeightOptions{})
+	if w[0].Weight != HEAVY || !w[0].Position {
+		t.Fatalf("Weight() failed. Returned %v", w)
+	}
+
+	// A final consonant before a vowel makes no position
+	w = Weight("ἔπος ἔφη", WeightOptions{})
+	if !weightsEqual(weights(w), []SyllableWeight{LIGHT, LIGHT, LIGHT, HEAVY}) {
+		t.Fatalf("Weight() failed. Returned %v", w)
+	}
+	if w[2].Syllable != "σἔ" || w[2].Word != 1 {
+		t.Fatalf("Weight() failed. Returned %v", w[2])
+	}
+}
+
+func TestWeightMutaCumLiquida(t *testing.T) {
+	w := Weight("πατρός", WeightOptions{})
+	if w[0].Weight != HEAVY {
+		t.Fatalf("Weight() failed. Returned %v", w)
+	}
+	w = Weight("πατρός", WeightOptions{MutaCumLiquidaLight: true})
+	if w[0].Weight != UNKNOWN_WEIGHT {
+		t.Fatalf("Weight() failed. Returned %v", w)
+	}
+	w = Weight("τέκνον", WeightOptions{MutaCumLiquidaLight: true})
+	if w[0].Weight != LIGHT {
+		t.Fatalf("Weight() failed. Returned %v", w)
+	}
+}
+
+func TestWeightHiatus(t *testing.T) {
+	w := Weight("πλάγχθη ἐπεὶ", WeightOptions{})
+	if w[1].Weight != HEAVY {
+		t.Fatalf("Weight() failed. Returned %v", w)
+	}
+	w = Weight("πλάγχθη ἐπεὶ", WeightOptions{Hiatus: true})
+	if w[1].Weight != LIGHT {
+		t.Fatalf("Weight() failed. Returned %v", w)
+	}
+}